@@ -16,10 +16,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func GetAccountIDAndPartition(iamconn *iam.IAM, stsconn *sts.STS, authProviderName string) (string, string, error) {
@@ -289,6 +291,55 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 	return assumeRoleCreds, nil
 }
 
+// ec2ConnWithAssumedRole returns an EC2 client using credentials for a role
+// in another account, e.g. the other side of a cross-account connection. An
+// empty region keeps the region of the given client.
+func ec2ConnWithAssumedRole(conn *ec2.EC2, roleArn, externalId, sessionName, region string) (*ec2.EC2, error) {
+	// The STS client must not inherit a custom EC2 endpoint.
+	config := conn.Client.Config.Copy()
+	endpoint := config.Endpoint
+	config.Endpoint = nil
+
+	if region != "" && region != aws.StringValue(config.Region) {
+		config.Region = aws.String(region)
+		endpoint = nil
+	}
+
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating session for role (%s): %s", roleArn, err)
+	}
+
+	creds := stscreds.NewCredentials(sess, roleArn, func(p *stscreds.AssumeRoleProvider) {
+		if externalId != "" {
+			p.ExternalID = aws.String(externalId)
+		}
+		if sessionName != "" {
+			p.RoleSessionName = sessionName
+		}
+	})
+
+	// Roles created in the same apply may not be assumable yet.
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := creds.Get()
+		if isAWSErr(err, "AccessDenied", "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error assuming role (%s): %s", roleArn, err)
+	}
+
+	return ec2.New(sess, &aws.Config{
+		Credentials: creds,
+		Endpoint:    endpoint,
+	}), nil
+}
+
 func setOptionalEndpoint(cfg *aws.Config) string {
 	endpoint := os.Getenv("AWS_METADATA_URL")
 	if endpoint != "" {
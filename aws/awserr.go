@@ -19,6 +19,19 @@ func isAWSErr(err error, code string, message string) bool {
 	return false
 }
 
+// isAWSErrCode returns true if err is of type awserr.Error and its Code()
+// matches any of the given codes
+func isAWSErrCode(err error, codes ...string) bool {
+	if err, ok := err.(awserr.Error); ok {
+		for _, code := range codes {
+			if err.Code() == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // IsAWSErrExtended returns true if the error matches all conditions
 //  * err is of type awserr.Error
 //  * Error.Code() matches code
@@ -57,13 +70,8 @@ func RetryOnAwsCodes(codes []string, f func() (interface{}, error)) (interface{}
 		var err error
 		resp, err = f()
 		if err != nil {
-			awsErr, ok := err.(awserr.Error)
-			if ok {
-				for _, code := range codes {
-					if awsErr.Code() == code {
-						return resource.RetryableError(err)
-					}
-				}
+			if isAWSErrCode(err, codes...) {
+				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
 		}
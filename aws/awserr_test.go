@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestIsAWSErrCode(t *testing.T) {
+	var testCases = []struct {
+		Err      error
+		Codes    []string
+		Expected bool
+	}{
+		{
+			Err:      nil,
+			Codes:    []string{"InvalidGroup.NotFound"},
+			Expected: false,
+		},
+		{
+			Err:      errors.New("InvalidGroup.NotFound"),
+			Codes:    []string{"InvalidGroup.NotFound"},
+			Expected: false,
+		},
+		{
+			Err:      awserr.New("InvalidGroup.NotFound", "The security group does not exist", nil),
+			Codes:    []string{},
+			Expected: false,
+		},
+		{
+			Err:      awserr.New("InvalidGroup.NotFound", "The security group does not exist", nil),
+			Codes:    []string{"InvalidGroup.NotFound"},
+			Expected: true,
+		},
+		{
+			Err:      awserr.New("InvalidGroup.NotFound", "The security group does not exist", nil),
+			Codes:    []string{"InvalidSecurityGroupID.NotFound", "InvalidGroup.NotFound"},
+			Expected: true,
+		},
+		{
+			Err:      awserr.New("InvalidGroup.InUse", "The security group is in use", nil),
+			Codes:    []string{"InvalidSecurityGroupID.NotFound", "InvalidGroup.NotFound"},
+			Expected: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		if got := isAWSErrCode(tc.Err, tc.Codes...); got != tc.Expected {
+			t.Fatalf("%d: expected %t, received %t for error %v and codes %v", i, tc.Expected, got, tc.Err, tc.Codes)
+		}
+	}
+}
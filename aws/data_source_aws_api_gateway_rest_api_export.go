@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsApiGatewayRestApiExport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsApiGatewayRestApiExportRead,
+		Schema: map[string]*schema.Schema{
+			"rest_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stage_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"export_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "oas30",
+				ValidateFunc: validation.StringInSlice([]string{"oas30", "swagger"}, false),
+			},
+			"accepts": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "application/json",
+				ValidateFunc: validation.StringInSlice([]string{"application/json", "application/yaml"}, false),
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"body": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_disposition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsApiGatewayRestApiExportRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	restApiId := d.Get("rest_api_id").(string)
+	stageName := d.Get("stage_name").(string)
+
+	input := &apigateway.GetExportInput{
+		Accepts:    aws.String(d.Get("accepts").(string)),
+		ExportType: aws.String(d.Get("export_type").(string)),
+		RestApiId:  aws.String(restApiId),
+		StageName:  aws.String(stageName),
+	}
+
+	if v, ok := d.GetOk("parameters"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Parameters = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Exporting API Gateway REST API: %s", input)
+	resp, err := conn.GetExport(input)
+	if err != nil {
+		return fmt.Errorf("error exporting API Gateway REST API (%s) stage (%s): %s", restApiId, stageName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", restApiId, stageName))
+	d.Set("body", string(resp.Body))
+	d.Set("content_type", resp.ContentType)
+	d.Set("content_disposition", resp.ContentDisposition)
+
+	return nil
+}
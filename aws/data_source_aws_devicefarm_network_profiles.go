@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsDevicefarmNetworkProfiles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsDevicefarmNetworkProfilesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  devicefarm.NetworkProfileTypeCurated,
+				ValidateFunc: validation.StringInSlice([]string{
+					devicefarm.NetworkProfileTypeCurated,
+					devicefarm.NetworkProfileTypePrivate,
+				}, false),
+			},
+
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsDevicefarmNetworkProfilesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).devicefarmconn
+
+	projectArn := d.Get("project_arn").(string)
+	profileType := d.Get("type").(string)
+	input := &devicefarm.ListNetworkProfilesInput{
+		Arn:  aws.String(projectArn),
+		Type: aws.String(profileType),
+	}
+
+	arns := make([]string, 0)
+	names := make([]string, 0)
+	for {
+		log.Printf("[DEBUG] Listing DeviceFarm Network Profiles: %s", input)
+		out, err := conn.ListNetworkProfiles(input)
+		if err != nil {
+			return fmt.Errorf("Error listing DeviceFarm Network Profiles: %s", err)
+		}
+
+		for _, profile := range out.NetworkProfiles {
+			arns = append(arns, aws.StringValue(profile.Arn))
+			names = append(names, aws.StringValue(profile.Name))
+		}
+
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s", projectArn, profileType))
+	d.Set("arns", arns)
+	d.Set("names", names)
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsDmsCertificate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsDmsCertificateRead,
+
+		Schema: map[string]*schema.Schema{
+			"certificate_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validateArn,
+				ConflictsWith: []string{"certificate_id"},
+			},
+			"certificate_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validateDmsCertificateId,
+				ConflictsWith: []string{"certificate_arn"},
+			},
+			"certificate_creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_pem": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"signing_algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"valid_from_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"valid_to_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsDmsCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	var filter *dms.Filter
+	if v, ok := d.GetOk("certificate_arn"); ok {
+		filter = &dms.Filter{
+			Name:   aws.String("certificate-arn"),
+			Values: []*string{aws.String(v.(string))},
+		}
+	} else if v, ok := d.GetOk("certificate_id"); ok {
+		filter = &dms.Filter{
+			Name:   aws.String("certificate-id"),
+			Values: []*string{aws.String(v.(string))},
+		}
+	} else {
+		return fmt.Errorf("One of certificate_arn or certificate_id must be set")
+	}
+
+	input := &dms.DescribeCertificatesInput{
+		Filters: []*dms.Filter{filter},
+	}
+
+	log.Printf("[DEBUG] Reading DMS Certificate: %s", input)
+	resp, err := conn.DescribeCertificates(input)
+	if isAWSErr(err, dms.ErrCodeResourceNotFoundFault, "") || (err == nil && len(resp.Certificates) == 0) {
+		return fmt.Errorf("No DMS Certificate found matching %s", filter)
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading DMS Certificate: %s", err)
+	}
+
+	cert := resp.Certificates[0]
+
+	d.SetId(aws.StringValue(cert.CertificateIdentifier))
+	d.Set("certificate_arn", cert.CertificateArn)
+	d.Set("certificate_id", cert.CertificateIdentifier)
+	d.Set("certificate_owner", cert.CertificateOwner)
+	d.Set("certificate_pem", cert.CertificatePem)
+	d.Set("key_length", cert.KeyLength)
+	d.Set("signing_algorithm", cert.SigningAlgorithm)
+	d.Set("certificate_creation_date", "")
+	if cert.CertificateCreationDate != nil {
+		d.Set("certificate_creation_date", aws.TimeValue(cert.CertificateCreationDate).Format(time.RFC3339))
+	}
+	d.Set("valid_from_date", "")
+	if cert.ValidFromDate != nil {
+		d.Set("valid_from_date", aws.TimeValue(cert.ValidFromDate).Format(time.RFC3339))
+	}
+	d.Set("valid_to_date", "")
+	if cert.ValidToDate != nil {
+		d.Set("valid_to_date", aws.TimeValue(cert.ValidToDate).Format(time.RFC3339))
+	}
+
+	return nil
+}
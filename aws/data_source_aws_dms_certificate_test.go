@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDmsCertificateDataSource_basic(t *testing.T) {
+	resourceName := "aws_dms_certificate.dms_certificate"
+	randId := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: dmsCertificateDataSourceConfig(randId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.aws_dms_certificate.by_id", "certificate_arn", resourceName, "certificate_arn"),
+					resource.TestCheckResourceAttrPair("data.aws_dms_certificate.by_arn", "certificate_id", resourceName, "certificate_id"),
+					resource.TestCheckResourceAttrPair("data.aws_dms_certificate.by_id", "valid_to_date", resourceName, "valid_to_date"),
+					resource.TestCheckResourceAttr("data.aws_dms_certificate.by_id", "valid_from_date", "2017-01-30T19:20:08Z"),
+					resource.TestCheckResourceAttrSet("data.aws_dms_certificate.by_id", "key_length"),
+					resource.TestCheckResourceAttrSet("data.aws_dms_certificate.by_id", "signing_algorithm"),
+				),
+			},
+		},
+	})
+}
+
+func dmsCertificateDataSourceConfig(randId string) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_dms_certificate" "by_id" {
+  certificate_id = "${aws_dms_certificate.dms_certificate.certificate_id}"
+}
+
+data "aws_dms_certificate" "by_arn" {
+  certificate_arn = "${aws_dms_certificate.dms_certificate.certificate_arn}"
+}
+`, dmsCertificateConfig(randId))
+}
@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsDmsReplicationTaskStatistics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsDmsReplicationTaskStatisticsRead,
+
+		Schema: map[string]*schema.Schema{
+			"replication_task_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"schema-name",
+								"table-name",
+								"table-state",
+							}, false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+			"table_statistics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ddls": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"deletes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"full_load_condtnl_chk_failed_rows": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"full_load_error_rows": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"full_load_rows": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"inserts": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"last_update_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"table_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updates": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"validation_failed_records": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"validation_pending_records": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"validation_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"validation_state_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"validation_suspended_records": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsDmsReplicationTaskStatisticsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	taskArn := d.Get("replication_task_arn").(string)
+
+	input := &dms.DescribeTableStatisticsInput{
+		ReplicationTaskArn: aws.String(taskArn),
+	}
+
+	if v := d.Get("filter").(*schema.Set); v.Len() > 0 {
+		input.Filters = expandDmsFilters(v.List())
+	}
+
+	log.Printf("[DEBUG] Reading DMS Replication Task table statistics: %s", input)
+
+	var tableStatistics []*dms.TableStatistics
+	err := conn.DescribeTableStatisticsPages(input, func(page *dms.DescribeTableStatisticsOutput, lastPage bool) bool {
+		tableStatistics = append(tableStatistics, page.TableStatistics...)
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading DMS Replication Task (%s) table statistics: %s", taskArn, err)
+	}
+
+	d.SetId(taskArn)
+
+	if err := d.Set("table_statistics", flattenDmsTableStatistics(tableStatistics)); err != nil {
+		return fmt.Errorf("error setting table_statistics: %s", err)
+	}
+
+	return nil
+}
+
+func expandDmsFilters(in []interface{}) []*dms.Filter {
+	out := make([]*dms.Filter, len(in))
+	for i, filter := range in {
+		m := filter.(map[string]interface{})
+
+		out[i] = &dms.Filter{
+			Name:   aws.String(m["name"].(string)),
+			Values: expandStringList(m["values"].(*schema.Set).List()),
+		}
+	}
+	return out
+}
+
+func flattenDmsTableStatistics(tableStatistics []*dms.TableStatistics) []interface{} {
+	l := make([]interface{}, 0, len(tableStatistics))
+
+	for _, ts := range tableStatistics {
+		if ts == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"ddls":                              int(aws.Int64Value(ts.Ddls)),
+			"deletes":                           int(aws.Int64Value(ts.Deletes)),
+			"full_load_condtnl_chk_failed_rows": int(aws.Int64Value(ts.FullLoadCondtnlChkFailedRows)),
+			"full_load_error_rows":              int(aws.Int64Value(ts.FullLoadErrorRows)),
+			"full_load_rows":                    int(aws.Int64Value(ts.FullLoadRows)),
+			"inserts":                           int(aws.Int64Value(ts.Inserts)),
+			"last_update_time":                  "",
+			"schema_name":                       aws.StringValue(ts.SchemaName),
+			"table_name":                        aws.StringValue(ts.TableName),
+			"table_state":                       aws.StringValue(ts.TableState),
+			"updates":                           int(aws.Int64Value(ts.Updates)),
+			"validation_failed_records":         int(aws.Int64Value(ts.ValidationFailedRecords)),
+			"validation_pending_records":        int(aws.Int64Value(ts.ValidationPendingRecords)),
+			"validation_state":                  aws.StringValue(ts.ValidationState),
+			"validation_state_details":          aws.StringValue(ts.ValidationStateDetails),
+			"validation_suspended_records":      int(aws.Int64Value(ts.ValidationSuspendedRecords)),
+		}
+
+		if ts.LastUpdateTime != nil {
+			m["last_update_time"] = aws.TimeValue(ts.LastUpdateTime).Format(time.RFC3339)
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
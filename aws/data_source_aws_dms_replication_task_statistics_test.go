@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDmsReplicationTaskStatisticsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_dms_replication_task_statistics.test"
+	resourceName := "aws_dms_replication_task.dms_replication_task"
+	randId := acctest.RandString(8) + "-data"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDmsReplicationTaskStatisticsDataSourceConfig(randId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "replication_task_arn", resourceName, "replication_task_arn"),
+					// The task is never started, so no tables have been loaded yet.
+					resource.TestCheckResourceAttr(dataSourceName, "table_statistics.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDmsReplicationTaskStatisticsDataSourceConfig(randId string) string {
+	return dmsReplicationTaskConfig(randId) + `
+data "aws_dms_replication_task_statistics" "test" {
+  replication_task_arn = "${aws_dms_replication_task.dms_replication_task.replication_task_arn}"
+
+  filter {
+    name   = "table-state"
+    values = ["Table completed"]
+  }
+}
+`
+}
@@ -12,6 +12,11 @@ import (
 
 var dataSourceAwsIamPolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
+const (
+	iamPolicyDocumentMergeStrategyStatement = "statement"
+	iamPolicyDocumentMergeStrategyCondition = "condition"
+)
+
 func dataSourceAwsIamPolicyDocument() *schema.Resource {
 	setOfString := &schema.Schema{
 		Type:     schema.TypeSet,
@@ -25,6 +30,15 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 		Read: dataSourceAwsIamPolicyDocumentRead,
 
 		Schema: map[string]*schema.Schema{
+			"merge_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  iamPolicyDocumentMergeStrategyStatement,
+				ValidateFunc: validation.StringInSlice([]string{
+					iamPolicyDocumentMergeStrategyStatement,
+					iamPolicyDocumentMergeStrategyCondition,
+				}, false),
+			},
 			"override_json": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -95,6 +109,13 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
 	mergedDoc := &IAMPolicyDoc{}
 
+	merge := mergedDoc.Merge
+	mergeOverride := mergedDoc.Merge
+	if d.Get("merge_strategy").(string) == iamPolicyDocumentMergeStrategyCondition {
+		merge = func(doc *IAMPolicyDoc) { mergedDoc.MergeConditions(doc, false) }
+		mergeOverride = func(doc *IAMPolicyDoc) { mergedDoc.MergeConditions(doc, true) }
+	}
+
 	// populate mergedDoc directly with any source_json
 	if sourceJSON, hasSourceJSON := d.GetOk("source_json"); hasSourceJSON {
 		if err := json.Unmarshal([]byte(sourceJSON.(string)), mergedDoc); err != nil {
@@ -162,7 +183,7 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 	}
 
 	// merge our current document into mergedDoc
-	mergedDoc.Merge(doc)
+	merge(doc)
 
 	// merge in override_json
 	if overrideJSON, hasOverrideJSON := d.GetOk("override_json"); hasOverrideJSON {
@@ -171,7 +192,7 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 			return err
 		}
 
-		mergedDoc.Merge(overrideDoc)
+		mergeOverride(overrideDoc)
 	}
 
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
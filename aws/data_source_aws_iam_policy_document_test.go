@@ -90,6 +90,23 @@ func TestAccAWSDataSourceIAMPolicyDocument_override(t *testing.T) {
 	})
 }
 
+func TestAccAWSDataSourceIAMPolicyDocument_overrideMergeConditions(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentOverrideMergeConditionsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateValue("data.aws_iam_policy_document.test_override", "json",
+						testAccAWSIAMPolicyDocumentOverrideMergeConditionsExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDataSourceIAMPolicyDocument_noStatementMerge(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -555,6 +572,65 @@ var testAccAWSIAMPolicyDocumentOverrideExpectedJSON = `{
   ]
 }`
 
+var testAccAWSIAMPolicyDocumentOverrideMergeConditionsConfig = `
+data "aws_iam_policy_document" "override" {
+  statement {
+    sid = "BucketAccess"
+
+    condition {
+      test     = "StringEquals"
+      variable = "aws:SourceVpc"
+      values   = ["vpc-22222222"]
+    }
+  }
+}
+
+data "aws_iam_policy_document" "test_override" {
+  merge_strategy = "condition"
+  override_json  = "${data.aws_iam_policy_document.override.json}"
+
+  statement {
+    sid       = "BucketAccess"
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::somebucket/*"]
+
+    condition {
+      test     = "StringEquals"
+      variable = "aws:SourceVpc"
+      values   = ["vpc-11111111"]
+    }
+
+    condition {
+      test     = "Bool"
+      variable = "aws:SecureTransport"
+      values   = ["true"]
+    }
+  }
+}
+`
+
+var testAccAWSIAMPolicyDocumentOverrideMergeConditionsExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "BucketAccess",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::somebucket/*",
+      "Condition": {
+        "Bool": {
+          "aws:SecureTransport": "true"
+        },
+        "StringEquals": {
+          "aws:SourceVpc": [
+            "vpc-22222222"
+          ]
+        }
+      }
+    }
+  ]
+}`
+
 var testAccAWSIAMPolicyDocumentNoStatementMergeConfig = `
 data "aws_iam_policy_document" "source" {
   statement {
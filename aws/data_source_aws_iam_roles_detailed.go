@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIAMRolesDetailed() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIAMRolesDetailedRead,
+
+		Schema: map[string]*schema.Schema{
+			"path_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attached_policy_arns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"create_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_session_duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permissions_boundary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unique_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIAMRolesDetailedRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	pathPrefix := d.Get("path_prefix").(string)
+	nameRegex := d.Get("name_regex").(string)
+
+	var r *regexp.Regexp
+	if nameRegex != "" {
+		r = regexp.MustCompile(nameRegex)
+	}
+
+	input := &iam.ListRolesInput{
+		PathPrefix: aws.String(pathPrefix),
+	}
+
+	var roles []*iam.Role
+	log.Printf("[DEBUG] Listing IAM Roles: %s", input)
+	err := conn.ListRolesPages(input, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		for _, role := range page.Roles {
+			if r != nil && !r.MatchString(aws.StringValue(role.RoleName)) {
+				continue
+			}
+			roles = append(roles, role)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing IAM Roles: %s", err)
+	}
+
+	arns := make([]string, 0, len(roles))
+	names := make([]string, 0, len(roles))
+	details := make([]map[string]interface{}, 0, len(roles))
+	for _, role := range roles {
+		roleName := aws.StringValue(role.RoleName)
+
+		// ListRoles doesn't return the permissions boundary.
+		out, err := conn.GetRole(&iam.GetRoleInput{
+			RoleName: role.RoleName,
+		})
+		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+			log.Printf("[WARN] IAM Role %s deleted while listing, skipping", roleName)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("Error reading IAM Role %s: %s", roleName, err)
+		}
+
+		policyArns, err := dataSourceAwsIAMRolesDetailedAttachedPolicyArns(conn, roleName)
+		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+			log.Printf("[WARN] IAM Role %s deleted while listing, skipping", roleName)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("Error listing policies attached to IAM Role %s: %s", roleName, err)
+		}
+
+		m := map[string]interface{}{
+			"arn":                  aws.StringValue(out.Role.Arn),
+			"attached_policy_arns": policyArns,
+			"create_date":          aws.TimeValue(out.Role.CreateDate).Format(time.RFC3339),
+			"description":          aws.StringValue(out.Role.Description),
+			"max_session_duration": int(aws.Int64Value(out.Role.MaxSessionDuration)),
+			"name":                 roleName,
+			"path":                 aws.StringValue(out.Role.Path),
+			"permissions_boundary": "",
+			"unique_id":            aws.StringValue(out.Role.RoleId),
+		}
+		if out.Role.PermissionsBoundary != nil {
+			m["permissions_boundary"] = aws.StringValue(out.Role.PermissionsBoundary.PermissionsBoundaryArn)
+		}
+
+		arns = append(arns, aws.StringValue(out.Role.Arn))
+		names = append(names, roleName)
+		details = append(details, m)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(pathPrefix+nameRegex)))
+	d.Set("arns", arns)
+	d.Set("names", names)
+	if err := d.Set("roles", details); err != nil {
+		return fmt.Errorf("Error setting roles: %s", err)
+	}
+
+	return nil
+}
+
+func dataSourceAwsIAMRolesDetailedAttachedPolicyArns(conn *iam.IAM, roleName string) ([]string, error) {
+	policyArns := make([]string, 0)
+	err := conn.ListAttachedRolePoliciesPages(&iam.ListAttachedRolePoliciesInput{
+		RoleName: aws.String(roleName),
+	}, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+		for _, policy := range page.AttachedPolicies {
+			policyArns = append(policyArns, aws.StringValue(policy.PolicyArn))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policyArns, nil
+}
@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMRolesDetailed_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_iam_roles_detailed.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsIAMRolesDetailedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.0", rName+"-first"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", "aws_iam_role.first", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.0.path", "/"+rName+"/"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.0.permissions_boundary", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.0.attached_policy_arns.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.0.attached_policy_arns.0", "arn:aws:iam::aws:policy/ReadOnlyAccess"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "roles.0.create_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "roles.0.unique_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsIAMRolesDetailedConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "first" {
+  name = "%[1]s-first"
+  path = "/%[1]s/"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role" "second" {
+  name               = "%[1]s-second"
+  path               = "/%[1]s/"
+  assume_role_policy = "${aws_iam_role.first.assume_role_policy}"
+}
+
+resource "aws_iam_role_policy_attachment" "first" {
+  role       = "${aws_iam_role.first.name}"
+  policy_arn = "arn:aws:iam::aws:policy/ReadOnlyAccess"
+}
+
+data "aws_iam_roles_detailed" "test" {
+  path_prefix = "/%[1]s/"
+  name_regex  = "-first$"
+
+  depends_on = ["aws_iam_role.second", "aws_iam_role_policy_attachment.first"]
+}
+`, rName)
+}
@@ -22,8 +22,7 @@ func dataSourceAwsPricingProduct() *schema.Resource {
 			},
 			"filters": {
 				Type:     schema.TypeList,
-				Required: true,
-				MinItems: 1,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"field": {
@@ -37,10 +36,47 @@ func dataSourceAwsPricingProduct() *schema.Resource {
 					},
 				},
 			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"operating_system": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tenancy": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"result": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"sku": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product_family": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product_attributes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"on_demand_price_per_unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"on_demand_price_unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -63,6 +99,21 @@ func dataSourceAwsPricingProductRead(d *schema.ResourceData, meta interface{}) e
 		})
 	}
 
+	// Shortcut arguments for the most common product attributes.
+	for _, f := range pricingProductFilterFields {
+		if v, ok := d.GetOk(f.attribute); ok {
+			params.Filters = append(params.Filters, &pricing.Filter{
+				Field: aws.String(f.field),
+				Value: aws.String(v.(string)),
+				Type:  aws.String(pricing.FilterTypeTermMatch),
+			})
+		}
+	}
+
+	if len(params.Filters) == 0 {
+		return fmt.Errorf("At least one of filters, instance_type, location, operating_system or tenancy must be set")
+	}
+
 	log.Printf("[DEBUG] Reading pricing of products: %s", params)
 	resp, err := conn.GetProducts(params)
 	if err != nil {
@@ -88,5 +139,84 @@ func dataSourceAwsPricingProductRead(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(fmt.Sprintf("%d", hashcode.String(params.String())))
 	d.Set("result", string(pricingResult))
+
+	product := flattenPricingProduct(resp.PriceList[0])
+	d.Set("sku", product.sku)
+	d.Set("product_family", product.productFamily)
+	d.Set("on_demand_price_per_unit", product.onDemandPricePerUnit)
+	d.Set("on_demand_price_unit", product.onDemandPriceUnit)
+	if err := d.Set("product_attributes", product.attributes); err != nil {
+		return fmt.Errorf("error setting product_attributes: %s", err)
+	}
+
 	return nil
 }
+
+// pricingProductFilterFields maps the shortcut arguments to the product
+// attribute names used by the GetProducts API.
+var pricingProductFilterFields = []struct {
+	attribute string
+	field     string
+}{
+	{"instance_type", "instanceType"},
+	{"location", "location"},
+	{"operating_system", "operatingSystem"},
+	{"tenancy", "tenancy"},
+}
+
+type pricingProduct struct {
+	sku                  string
+	productFamily        string
+	attributes           map[string]string
+	onDemandPricePerUnit string
+	onDemandPriceUnit    string
+}
+
+// flattenPricingProduct extracts the product details from a GetProducts price
+// list element. The on-demand price is only set when the product has a single
+// on-demand term with a single price dimension, tiered pricing is left to the
+// raw result.
+func flattenPricingProduct(priceList aws.JSONValue) pricingProduct {
+	result := pricingProduct{
+		attributes: map[string]string{},
+	}
+
+	if product, ok := priceList["product"].(map[string]interface{}); ok {
+		result.sku, _ = product["sku"].(string)
+		result.productFamily, _ = product["productFamily"].(string)
+
+		if attributes, ok := product["attributes"].(map[string]interface{}); ok {
+			for k, v := range attributes {
+				if s, ok := v.(string); ok {
+					result.attributes[k] = s
+				}
+			}
+		}
+	}
+
+	terms, _ := priceList["terms"].(map[string]interface{})
+	onDemand, _ := terms["OnDemand"].(map[string]interface{})
+	if len(onDemand) != 1 {
+		return result
+	}
+
+	for _, t := range onDemand {
+		term, _ := t.(map[string]interface{})
+		dimensions, _ := term["priceDimensions"].(map[string]interface{})
+		if len(dimensions) != 1 {
+			return result
+		}
+
+		for _, v := range dimensions {
+			dimension, _ := v.(map[string]interface{})
+			pricePerUnit, _ := dimension["pricePerUnit"].(map[string]interface{})
+
+			if price, ok := pricePerUnit["USD"].(string); ok {
+				result.onDemandPricePerUnit = price
+				result.onDemandPriceUnit, _ = dimension["unit"].(string)
+			}
+		}
+	}
+
+	return result
+}
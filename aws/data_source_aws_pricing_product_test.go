@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -29,6 +31,29 @@ func TestAccDataSourceAwsPricingProduct_ec2(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAwsPricingProduct_ec2Shortcuts(t *testing.T) {
+	oldRegion := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldRegion)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsPricingProductConfigEc2Shortcuts("c5.large"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccPricingCheckValueIsJSON("data.aws_pricing_product.test"),
+					resource.TestCheckResourceAttrSet("data.aws_pricing_product.test", "sku"),
+					resource.TestCheckResourceAttr("data.aws_pricing_product.test", "product_family", "Compute Instance"),
+					resource.TestCheckResourceAttr("data.aws_pricing_product.test", "product_attributes.instanceType", "c5.large"),
+					resource.TestCheckResourceAttrSet("data.aws_pricing_product.test", "on_demand_price_per_unit"),
+					resource.TestCheckResourceAttr("data.aws_pricing_product.test", "on_demand_price_unit", "Hrs"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSourceAwsPricingProduct_redshift(t *testing.T) {
 	oldRegion := os.Getenv("AWS_DEFAULT_REGION")
 	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
@@ -82,6 +107,33 @@ func testAccDataSourceAwsPricingProductConfigEc2(dataName string, instanceType s
 `, dataName, instanceType)
 }
 
+func testAccDataSourceAwsPricingProductConfigEc2Shortcuts(instanceType string) string {
+	return fmt.Sprintf(`
+data "aws_pricing_product" "test" {
+  service_code     = "AmazonEC2"
+  instance_type    = %q
+  location         = "US East (N. Virginia)"
+  operating_system = "Linux"
+  tenancy          = "Shared"
+
+  filters = [
+    {
+      field = "preInstalledSw"
+      value = "NA"
+    },
+    {
+      field = "licenseModel"
+      value = "No License required"
+    },
+    {
+      field = "capacitystatus"
+      value = "Used"
+    },
+  ]
+}
+`, instanceType)
+}
+
 func testAccDataSourceAwsPricingProductConfigRedshift() string {
 	return fmt.Sprintf(`data "aws_pricing_product" "test" {
 		service_code = "AmazonRedshift"
@@ -122,3 +174,84 @@ func testAccPricingCheckValueIsJSON(data string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestFlattenPricingProduct(t *testing.T) {
+	cases := []struct {
+		Name      string
+		PriceList aws.JSONValue
+		Expected  pricingProduct
+	}{
+		{
+			Name:      "empty",
+			PriceList: aws.JSONValue{},
+			Expected:  pricingProduct{attributes: map[string]string{}},
+		},
+		{
+			Name: "single price dimension",
+			PriceList: aws.JSONValue{
+				"product": map[string]interface{}{
+					"productFamily": "Compute Instance",
+					"sku":           "SKU1",
+					"attributes": map[string]interface{}{
+						"instanceType": "c5.large",
+					},
+				},
+				"terms": map[string]interface{}{
+					"OnDemand": map[string]interface{}{
+						"SKU1.TERM": map[string]interface{}{
+							"priceDimensions": map[string]interface{}{
+								"SKU1.TERM.DIM": map[string]interface{}{
+									"unit":         "Hrs",
+									"pricePerUnit": map[string]interface{}{"USD": "0.0850000000"},
+								},
+							},
+						},
+					},
+				},
+			},
+			Expected: pricingProduct{
+				sku:                  "SKU1",
+				productFamily:        "Compute Instance",
+				attributes:           map[string]string{"instanceType": "c5.large"},
+				onDemandPricePerUnit: "0.0850000000",
+				onDemandPriceUnit:    "Hrs",
+			},
+		},
+		{
+			Name: "tiered price dimensions",
+			PriceList: aws.JSONValue{
+				"product": map[string]interface{}{
+					"sku": "SKU2",
+				},
+				"terms": map[string]interface{}{
+					"OnDemand": map[string]interface{}{
+						"SKU2.TERM": map[string]interface{}{
+							"priceDimensions": map[string]interface{}{
+								"SKU2.TERM.DIM1": map[string]interface{}{
+									"unit":         "GB",
+									"pricePerUnit": map[string]interface{}{"USD": "0.09"},
+								},
+								"SKU2.TERM.DIM2": map[string]interface{}{
+									"unit":         "GB",
+									"pricePerUnit": map[string]interface{}{"USD": "0.085"},
+								},
+							},
+						},
+					},
+				},
+			},
+			Expected: pricingProduct{
+				sku:        "SKU2",
+				attributes: map[string]string{},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		got := flattenPricingProduct(tc.PriceList)
+
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("%s: got %#v, expected %#v", tc.Name, got, tc.Expected)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsResourceGroupsGroupResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsResourceGroupsGroupResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsResourceGroupsGroupResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupsconn
+
+	groupName := d.Get("group_name").(string)
+	input := &resourcegroups.ListGroupResourcesInput{
+		GroupName: aws.String(groupName),
+	}
+
+	if v, ok := d.GetOk("resource_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filters = []*resourcegroups.ResourceFilter{
+			{
+				Name:   aws.String(resourcegroups.ResourceFilterNameResourceType),
+				Values: expandStringSet(v.(*schema.Set)),
+			},
+		}
+	}
+
+	arns := make([]string, 0)
+	resources := make([]map[string]interface{}, 0)
+	log.Printf("[DEBUG] Listing Resource Groups group resources: %s", input)
+	err := conn.ListGroupResourcesPages(input, func(page *resourcegroups.ListGroupResourcesOutput, lastPage bool) bool {
+		for _, r := range page.ResourceIdentifiers {
+			arns = append(arns, aws.StringValue(r.ResourceArn))
+			resources = append(resources, map[string]interface{}{
+				"arn":  aws.StringValue(r.ResourceArn),
+				"type": aws.StringValue(r.ResourceType),
+			})
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing resources of Resource Groups group (%s): %s", groupName, err)
+	}
+
+	d.SetId(groupName)
+	d.Set("arns", arns)
+	if err := d.Set("resources", resources); err != nil {
+		return fmt.Errorf("Error setting resources: %s", err)
+	}
+
+	return nil
+}
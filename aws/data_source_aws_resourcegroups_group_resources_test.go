@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceAwsResourceGroupsGroupResources_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	vpcResourceName := "aws_vpc.test"
+	subnetResourceName := "aws_subnet.test"
+	allDataSourceName := "data.aws_resourcegroups_group_resources.all"
+	vpcDataSourceName := "data.aws_resourcegroups_group_resources.vpc"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccDeleteResourceGroupsGroup(rName),
+		Steps: []resource.TestStep{
+			{
+				// The group is not managed by the provider, so it is
+				// created here and deleted again in CheckDestroy.
+				PreConfig: func() { testAccCreateResourceGroupsGroup(t, rName) },
+				Config:    testAccDataSourceAwsResourceGroupsGroupResourcesConfigResources(rName),
+			},
+			{
+				Config: testAccDataSourceAwsResourceGroupsGroupResourcesConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(allDataSourceName, "arns.#", "2"),
+					resource.TestCheckResourceAttr(allDataSourceName, "resources.#", "2"),
+					resource.TestCheckResourceAttr(vpcDataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(vpcDataSourceName, "arns.0", vpcResourceName, "arn"),
+					resource.TestCheckResourceAttr(vpcDataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(vpcDataSourceName, "resources.0.arn", vpcResourceName, "arn"),
+					resource.TestCheckResourceAttr(vpcDataSourceName, "resources.0.type", "AWS::EC2::VPC"),
+					testAccCheckResourceGroupsGroupResourcesContains(allDataSourceName, vpcResourceName, "AWS::EC2::VPC"),
+					testAccCheckResourceGroupsGroupResourcesContains(allDataSourceName, subnetResourceName, "AWS::EC2::Subnet"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsResourceGroupsGroupResources_notFound(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAwsResourceGroupsGroupResourcesConfigNotFound(rName),
+				ExpectError: regexp.MustCompile(`NotFoundException`),
+			},
+		},
+	})
+}
+
+func testAccCreateResourceGroupsGroup(t *testing.T, name string) {
+	conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn
+
+	query := fmt.Sprintf(`{"ResourceTypeFilters":["AWS::AllSupported"],"TagFilters":[{"Key":"ResourceGroup","Values":[%q]}]}`, name)
+	_, err := conn.CreateGroup(&resourcegroups.CreateGroupInput{
+		Name: aws.String(name),
+		ResourceQuery: &resourcegroups.ResourceQuery{
+			Type:  aws.String(resourcegroups.QueryTypeTagFilters10),
+			Query: aws.String(query),
+		},
+	})
+	if err != nil {
+		t.Fatalf("error creating Resource Groups group (%s): %s", name, err)
+	}
+}
+
+func testAccDeleteResourceGroupsGroup(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn
+
+		_, err := conn.DeleteGroup(&resourcegroups.DeleteGroupInput{
+			GroupName: aws.String(name),
+		})
+		if isAWSErr(err, resourcegroups.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error deleting Resource Groups group (%s): %s", name, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceGroupsGroupResourcesContains(dataSourceName, resourceName, resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", dataSourceName)
+		}
+
+		arn := rs.Primary.Attributes["arn"]
+		count, err := strconv.Atoi(ds.Primary.Attributes["resources.#"])
+		if err != nil {
+			return fmt.Errorf("%s: error reading resources count: %s", dataSourceName, err)
+		}
+		for i := 0; i < count; i++ {
+			if ds.Primary.Attributes[fmt.Sprintf("resources.%d.arn", i)] != arn {
+				continue
+			}
+			if t := ds.Primary.Attributes[fmt.Sprintf("resources.%d.type", i)]; t != resourceType {
+				return fmt.Errorf("%s: expected type of %s to be %s, got %s", dataSourceName, arn, resourceType, t)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("%s: %s not found in resources", dataSourceName, arn)
+	}
+}
+
+func testAccDataSourceAwsResourceGroupsGroupResourcesConfigResources(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name          = %[1]q
+    ResourceGroup = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id     = "${aws_vpc.test.id}"
+  cidr_block = "10.1.1.0/24"
+
+  tags = {
+    Name          = %[1]q
+    ResourceGroup = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccDataSourceAwsResourceGroupsGroupResourcesConfigBasic(rName string) string {
+	return testAccDataSourceAwsResourceGroupsGroupResourcesConfigResources(rName) + fmt.Sprintf(`
+data "aws_resourcegroups_group_resources" "all" {
+  group_name = %[1]q
+}
+
+data "aws_resourcegroups_group_resources" "vpc" {
+  group_name     = %[1]q
+  resource_types = ["AWS::EC2::VPC"]
+}
+`, rName)
+}
+
+func testAccDataSourceAwsResourceGroupsGroupResourcesConfigNotFound(rName string) string {
+	return fmt.Sprintf(`
+data "aws_resourcegroups_group_resources" "test" {
+  group_name     = %q
+  resource_types = ["AWS::EC2::Instance"]
+}
+`, rName)
+}
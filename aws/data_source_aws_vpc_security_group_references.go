@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsVpcSecurityGroupReferences() *schema.Resource {
+	staleRuleSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cidr_blocks": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"from_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"prefix_list_ids": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"protocol": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"security_groups": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"to_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read: dataSourceAwsVpcSecurityGroupReferencesRead,
+
+		Schema: map[string]*schema.Schema{
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"references": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"referencing_vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_peering_connection_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"stale_security_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"egress":  staleRuleSchema,
+						"ingress": staleRuleSchema,
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsVpcSecurityGroupReferencesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	groupIds := expandStringSet(d.Get("security_group_ids").(*schema.Set))
+	vpcId := d.Get("vpc_id").(string)
+
+	if len(groupIds) == 0 && vpcId == "" {
+		return fmt.Errorf("One of security_group_ids or vpc_id must be set")
+	}
+
+	references := make([]map[string]interface{}, 0)
+	if len(groupIds) > 0 {
+		input := &ec2.DescribeSecurityGroupReferencesInput{
+			GroupId: groupIds,
+		}
+
+		log.Printf("[DEBUG] Reading Security Group References: %s", input)
+		resp, err := conn.DescribeSecurityGroupReferences(input)
+		if err != nil {
+			return fmt.Errorf("Error reading Security Group References: %s", err)
+		}
+
+		for _, ref := range resp.SecurityGroupReferenceSet {
+			references = append(references, map[string]interface{}{
+				"referencing_vpc_id":        aws.StringValue(ref.ReferencingVpcId),
+				"security_group_id":         aws.StringValue(ref.GroupId),
+				"vpc_peering_connection_id": aws.StringValue(ref.VpcPeeringConnectionId),
+			})
+		}
+	}
+
+	staleGroups := make([]map[string]interface{}, 0)
+	if vpcId != "" {
+		input := &ec2.DescribeStaleSecurityGroupsInput{
+			VpcId: aws.String(vpcId),
+		}
+
+		for {
+			log.Printf("[DEBUG] Reading Stale Security Groups: %s", input)
+			resp, err := conn.DescribeStaleSecurityGroups(input)
+			if err != nil {
+				return fmt.Errorf("Error reading Stale Security Groups: %s", err)
+			}
+
+			for _, group := range resp.StaleSecurityGroupSet {
+				staleGroups = append(staleGroups, map[string]interface{}{
+					"description":       aws.StringValue(group.Description),
+					"egress":            flattenStaleIpPermissions(group.StaleIpPermissionsEgress),
+					"ingress":           flattenStaleIpPermissions(group.StaleIpPermissions),
+					"name":              aws.StringValue(group.GroupName),
+					"security_group_id": aws.StringValue(group.GroupId),
+					"vpc_id":            aws.StringValue(group.VpcId),
+				})
+			}
+
+			if aws.StringValue(resp.NextToken) == "" {
+				break
+			}
+			input.NextToken = resp.NextToken
+		}
+	}
+
+	ids := aws.StringValueSlice(groupIds)
+	sort.Strings(ids)
+	d.SetId(fmt.Sprintf("%d", hashcode.String(vpcId+strings.Join(ids, ","))))
+
+	if err := d.Set("references", references); err != nil {
+		return fmt.Errorf("Error setting references: %s", err)
+	}
+	if err := d.Set("stale_security_groups", staleGroups); err != nil {
+		return fmt.Errorf("Error setting stale_security_groups: %s", err)
+	}
+
+	return nil
+}
+
+func flattenStaleIpPermissions(permissions []*ec2.StaleIpPermission) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(permissions))
+	for _, p := range permissions {
+		groups := make([]string, 0, len(p.UserIdGroupPairs))
+		for _, pair := range p.UserIdGroupPairs {
+			groups = append(groups, aws.StringValue(pair.GroupId))
+		}
+
+		result = append(result, map[string]interface{}{
+			"cidr_blocks":     aws.StringValueSlice(p.IpRanges),
+			"from_port":       int(aws.Int64Value(p.FromPort)),
+			"prefix_list_ids": aws.StringValueSlice(p.PrefixListIds),
+			"protocol":        aws.StringValue(p.IpProtocol),
+			"security_groups": groups,
+			"to_port":         int(aws.Int64Value(p.ToPort)),
+		})
+	}
+
+	return result
+}
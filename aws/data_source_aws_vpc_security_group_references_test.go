@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsVpcSecurityGroupReferences_basic(t *testing.T) {
+	dataSourceName := "data.aws_vpc_security_group_references.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsVpcSecurityGroupReferencesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "references.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "references.0.security_group_id", "aws_security_group.referenced", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "references.0.referencing_vpc_id", "aws_vpc.peer", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "references.0.vpc_peering_connection_id", "aws_vpc_peering_connection.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "stale_security_groups.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsVpcSecurityGroupReferencesConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  cidr_block = "10.2.0.0/16"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = "${aws_vpc.test.id}"
+  peer_vpc_id = "${aws_vpc.peer.id}"
+  auto_accept = true
+}
+
+resource "aws_security_group" "referenced" {
+  name   = "%[1]s-referenced"
+  vpc_id = "${aws_vpc.test.id}"
+}
+
+resource "aws_security_group" "referencing" {
+  name   = "%[1]s-referencing"
+  vpc_id = "${aws_vpc.peer.id}"
+
+  ingress {
+    from_port       = 443
+    to_port         = 443
+    protocol        = "tcp"
+    security_groups = ["${aws_security_group.referenced.id}"]
+  }
+
+  depends_on = ["aws_vpc_peering_connection.test"]
+}
+
+data "aws_vpc_security_group_references" "test" {
+  security_group_ids = ["${aws_security_group.referenced.id}"]
+  vpc_id             = "${aws_vpc.peer.id}"
+
+  depends_on = ["aws_security_group.referencing"]
+}
+`, rName)
+}
@@ -70,6 +70,80 @@ func (self *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// MergeConditions merges newDoc like Merge, except that statements with an
+// existing Sid are merged element by element: elements set in the new
+// statement replace the existing ones, and conditions are replaced per
+// condition operator and key, keeping any other existing conditions. The
+// Effect of the new statement is only taken when mergeEffect is set, as
+// statements built by aws_iam_policy_document always carry the default
+// "Allow", while a parsed JSON document only carries an explicit Effect.
+func (self *IAMPolicyDoc) MergeConditions(newDoc *IAMPolicyDoc, mergeEffect bool) {
+	merged := &IAMPolicyDoc{
+		Id:         newDoc.Id,
+		Version:    newDoc.Version,
+		Statements: make([]*IAMPolicyStatement, 0, len(newDoc.Statements)),
+	}
+
+	for _, newStatement := range newDoc.Statements {
+		if len(newStatement.Sid) > 0 {
+			for _, existingStatement := range self.Statements {
+				if existingStatement.Sid == newStatement.Sid {
+					newStatement = existingStatement.mergeConditions(newStatement, mergeEffect)
+					break
+				}
+			}
+		}
+		merged.Statements = append(merged.Statements, newStatement)
+	}
+
+	self.Merge(merged)
+}
+
+func (self *IAMPolicyStatement) mergeConditions(newStatement *IAMPolicyStatement, mergeEffect bool) *IAMPolicyStatement {
+	out := *self
+	out.Sid = newStatement.Sid
+
+	if mergeEffect && newStatement.Effect != "" {
+		out.Effect = newStatement.Effect
+	}
+
+	if newStatement.Actions != nil {
+		out.Actions = newStatement.Actions
+	}
+	if newStatement.NotActions != nil {
+		out.NotActions = newStatement.NotActions
+	}
+	if newStatement.Resources != nil {
+		out.Resources = newStatement.Resources
+	}
+	if newStatement.NotResources != nil {
+		out.NotResources = newStatement.NotResources
+	}
+	if len(newStatement.Principals) > 0 {
+		out.Principals = newStatement.Principals
+	}
+	if len(newStatement.NotPrincipals) > 0 {
+		out.NotPrincipals = newStatement.NotPrincipals
+	}
+
+	conditions := make(IAMPolicyStatementConditionSet, 0, len(self.Conditions)+len(newStatement.Conditions))
+	for _, c := range self.Conditions {
+		replaced := false
+		for _, nc := range newStatement.Conditions {
+			if c.Test == nc.Test && c.Variable == nc.Variable {
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			conditions = append(conditions, c)
+		}
+	}
+	out.Conditions = append(conditions, newStatement.Conditions...)
+
+	return &out
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestIAMPolicyDoc_MergeConditions(t *testing.T) {
+	doc := &IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*IAMPolicyStatement{
+			{
+				Sid:       "DenyUnencrypted",
+				Effect:    "Deny",
+				Actions:   "s3:PutObject",
+				Resources: "*",
+				Conditions: IAMPolicyStatementConditionSet{
+					{Test: "StringNotEquals", Variable: "s3:x-amz-server-side-encryption", Values: "AES256"},
+					{Test: "Bool", Variable: "aws:SecureTransport", Values: "false"},
+				},
+			},
+		},
+	}
+
+	doc.MergeConditions(&IAMPolicyDoc{
+		Statements: []*IAMPolicyStatement{
+			{
+				Sid:    "DenyUnencrypted",
+				Effect: "Allow",
+				Conditions: IAMPolicyStatementConditionSet{
+					{Test: "StringNotEquals", Variable: "s3:x-amz-server-side-encryption", Values: "aws:kms"},
+				},
+			},
+		},
+	}, false)
+
+	expected := &IAMPolicyStatement{
+		Sid:       "DenyUnencrypted",
+		Effect:    "Deny",
+		Actions:   "s3:PutObject",
+		Resources: "*",
+		Conditions: IAMPolicyStatementConditionSet{
+			{Test: "Bool", Variable: "aws:SecureTransport", Values: "false"},
+			{Test: "StringNotEquals", Variable: "s3:x-amz-server-side-encryption", Values: "aws:kms"},
+		},
+	}
+
+	if len(doc.Statements) != 1 {
+		t.Fatalf("expected 1 statement, got %d", len(doc.Statements))
+	}
+	if !reflect.DeepEqual(doc.Statements[0], expected) {
+		t.Fatalf("expected %#v, got %#v", expected, doc.Statements[0])
+	}
+}
+
+func TestIAMPolicyDoc_MergeConditionsDenyOverride(t *testing.T) {
+	doc := &IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*IAMPolicyStatement{
+			{
+				Sid:       "PutObjects",
+				Effect:    "Allow",
+				Actions:   "s3:PutObject",
+				Resources: "*",
+			},
+		},
+	}
+
+	override := &IAMPolicyDoc{}
+	err := json.Unmarshal([]byte(`{
+  "Statement": [
+    {
+      "Sid": "PutObjects",
+      "Effect": "Deny",
+      "Condition": {
+        "Bool": {
+          "aws:SecureTransport": "false"
+        }
+      }
+    }
+  ]
+}`), override)
+	if err != nil {
+		t.Fatalf("error parsing override: %s", err)
+	}
+
+	doc.MergeConditions(override, true)
+
+	expected := &IAMPolicyStatement{
+		Sid:       "PutObjects",
+		Effect:    "Deny",
+		Actions:   "s3:PutObject",
+		Resources: "*",
+		Conditions: IAMPolicyStatementConditionSet{
+			{Test: "Bool", Variable: "aws:SecureTransport", Values: []string{"false"}},
+		},
+	}
+
+	if len(doc.Statements) != 1 {
+		t.Fatalf("expected 1 statement, got %d", len(doc.Statements))
+	}
+	if !reflect.DeepEqual(doc.Statements[0], expected) {
+		t.Fatalf("expected %#v, got %#v", expected, doc.Statements[0])
+	}
+}
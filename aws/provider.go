@@ -4,6 +4,10 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
@@ -18,7 +22,7 @@ func Provider() terraform.ResourceProvider {
 	// TODO: Move the configuration to this, requires validation
 
 	// The actual provider
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -109,6 +113,8 @@ func Provider() terraform.ResourceProvider {
 
 			"endpoints": endpointsSchema(),
 
+			"default_timeouts": defaultTimeoutsSchema(),
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -166,6 +172,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_ami_ids":                          dataSourceAwsAmiIds(),
 			"aws_api_gateway_resource":             dataSourceAwsApiGatewayResource(),
 			"aws_api_gateway_rest_api":             dataSourceAwsApiGatewayRestApi(),
+			"aws_api_gateway_rest_api_export":      dataSourceAwsApiGatewayRestApiExport(),
 			"aws_arn":                              dataSourceAwsArn(),
 			"aws_autoscaling_groups":               dataSourceAwsAutoscalingGroups(),
 			"aws_availability_zone":                dataSourceAwsAvailabilityZone(),
@@ -186,6 +193,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_db_event_categories":              dataSourceAwsDbEventCategories(),
 			"aws_db_instance":                      dataSourceAwsDbInstance(),
 			"aws_db_snapshot":                      dataSourceAwsDbSnapshot(),
+			"aws_devicefarm_network_profiles":      dataSourceAwsDevicefarmNetworkProfiles(),
+			"aws_dms_certificate":                  dataSourceAwsDmsCertificate(),
+			"aws_dms_replication_task_statistics":  dataSourceAwsDmsReplicationTaskStatistics(),
 			"aws_dx_gateway":                       dataSourceAwsDxGateway(),
 			"aws_dynamodb_table":                   dataSourceAwsDynamoDbTable(),
 			"aws_ebs_snapshot":                     dataSourceAwsEbsSnapshot(),
@@ -214,6 +224,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_iam_policy":                       dataSourceAwsIAMPolicy(),
 			"aws_iam_policy_document":              dataSourceAwsIamPolicyDocument(),
 			"aws_iam_role":                         dataSourceAwsIAMRole(),
+			"aws_iam_roles_detailed":               dataSourceAwsIAMRolesDetailed(),
 			"aws_iam_server_certificate":           dataSourceAwsIAMServerCertificate(),
 			"aws_iam_user":                         dataSourceAwsIAMUser(),
 			"aws_internet_gateway":                 dataSourceAwsInternetGateway(),
@@ -244,6 +255,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_redshift_cluster":                 dataSourceAwsRedshiftCluster(),
 			"aws_redshift_service_account":         dataSourceAwsRedshiftServiceAccount(),
 			"aws_region":                           dataSourceAwsRegion(),
+			"aws_resourcegroups_group_resources":   dataSourceAwsResourceGroupsGroupResources(),
 			"aws_route":                            dataSourceAwsRoute(),
 			"aws_route_table":                      dataSourceAwsRouteTable(),
 			"aws_route_tables":                     dataSourceAwsRouteTables(),
@@ -266,6 +278,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_vpc_endpoint":                     dataSourceAwsVpcEndpoint(),
 			"aws_vpc_endpoint_service":             dataSourceAwsVpcEndpointService(),
 			"aws_vpc_peering_connection":           dataSourceAwsVpcPeeringConnection(),
+			"aws_vpc_security_group_references":    dataSourceAwsVpcSecurityGroupReferences(),
 			"aws_vpn_gateway":                      dataSourceAwsVpnGateway(),
 			"aws_workspaces_bundle":                dataSourceAwsWorkspaceBundle(),
 
@@ -288,6 +301,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_ami_launch_permission":                        resourceAwsAmiLaunchPermission(),
 			"aws_api_gateway_account":                          resourceAwsApiGatewayAccount(),
 			"aws_api_gateway_api_key":                          resourceAwsApiGatewayApiKey(),
+			"aws_api_gateway_api_keys_import":                  resourceAwsApiGatewayApiKeysImport(),
 			"aws_api_gateway_authorizer":                       resourceAwsApiGatewayAuthorizer(),
 			"aws_api_gateway_base_path_mapping":                resourceAwsApiGatewayBasePathMapping(),
 			"aws_api_gateway_client_certificate":               resourceAwsApiGatewayClientCertificate(),
@@ -306,6 +320,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_api_gateway_resource":                         resourceAwsApiGatewayResource(),
 			"aws_api_gateway_rest_api":                         resourceAwsApiGatewayRestApi(),
 			"aws_api_gateway_stage":                            resourceAwsApiGatewayStage(),
+			"aws_api_gateway_stage_method_settings":            resourceAwsApiGatewayStageMethodSettings(),
 			"aws_api_gateway_usage_plan":                       resourceAwsApiGatewayUsagePlan(),
 			"aws_api_gateway_usage_plan_key":                   resourceAwsApiGatewayUsagePlanKey(),
 			"aws_api_gateway_vpc_link":                         resourceAwsApiGatewayVpcLink(),
@@ -358,8 +373,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_cloudhsm_v2_hsm":                              resourceAwsCloudHsm2Hsm(),
 			"aws_cognito_resource_server":                      resourceAwsCognitoResourceServer(),
 			"aws_cloudwatch_metric_alarm":                      resourceAwsCloudWatchMetricAlarm(),
+			"aws_cloudwatch_metric_alarms":                     resourceAwsCloudWatchMetricAlarms(),
 			"aws_cloudwatch_dashboard":                         resourceAwsCloudWatchDashboard(),
 			"aws_codedeploy_app":                               resourceAwsCodeDeployApp(),
+			"aws_codedeploy_deployment":                        resourceAwsCodeDeployDeployment(),
 			"aws_codedeploy_deployment_config":                 resourceAwsCodeDeployDeploymentConfig(),
 			"aws_codedeploy_deployment_group":                  resourceAwsCodeDeployDeploymentGroup(),
 			"aws_codecommit_repository":                        resourceAwsCodeCommitRepository(),
@@ -380,7 +397,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_db_security_group":                            resourceAwsDbSecurityGroup(),
 			"aws_db_snapshot":                                  resourceAwsDbSnapshot(),
 			"aws_db_subnet_group":                              resourceAwsDbSubnetGroup(),
+			"aws_devicefarm_network_profile":                   resourceAwsDevicefarmNetworkProfile(),
 			"aws_devicefarm_project":                           resourceAwsDevicefarmProject(),
+			"aws_devicefarm_run":                               resourceAwsDevicefarmRun(),
 			"aws_directory_service_directory":                  resourceAwsDirectoryServiceDirectory(),
 			"aws_directory_service_conditional_forwarder":      resourceAwsDirectoryServiceConditionalForwarder(),
 			"aws_dms_certificate":                              resourceAwsDmsCertificate(),
@@ -388,6 +407,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_dms_replication_instance":                     resourceAwsDmsReplicationInstance(),
 			"aws_dms_replication_subnet_group":                 resourceAwsDmsReplicationSubnetGroup(),
 			"aws_dms_replication_task":                         resourceAwsDmsReplicationTask(),
+			"aws_dms_test_connection":                          resourceAwsDmsTestConnection(),
 			"aws_dx_bgp_peer":                                  resourceAwsDxBgpPeer(),
 			"aws_dx_connection":                                resourceAwsDxConnection(),
 			"aws_dx_connection_association":                    resourceAwsDxConnectionAssociation(),
@@ -448,7 +468,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_glue_classifier":                              resourceAwsGlueClassifier(),
 			"aws_glue_connection":                              resourceAwsGlueConnection(),
 			"aws_glue_crawler":                                 resourceAwsGlueCrawler(),
+			"aws_glue_data_catalog_encryption_settings":        resourceAwsGlueDataCatalogEncryptionSettings(),
 			"aws_glue_job":                                     resourceAwsGlueJob(),
+			"aws_glue_resource_policy":                         resourceAwsGlueResourcePolicy(),
 			"aws_glue_security_configuration":                  resourceAwsGlueSecurityConfiguration(),
 			"aws_glue_trigger":                                 resourceAwsGlueTrigger(),
 			"aws_guardduty_detector":                           resourceAwsGuardDutyDetector(),
@@ -456,6 +478,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_guardduty_member":                             resourceAwsGuardDutyMember(),
 			"aws_guardduty_threatintelset":                     resourceAwsGuardDutyThreatintelset(),
 			"aws_iam_access_key":                               resourceAwsIamAccessKey(),
+			"aws_iam_access_key_rotation":                      resourceAwsIamAccessKeyRotation(),
 			"aws_iam_account_alias":                            resourceAwsIamAccountAlias(),
 			"aws_iam_account_password_policy":                  resourceAwsIamAccountPasswordPolicy(),
 			"aws_iam_group_policy":                             resourceAwsIamGroupPolicy(),
@@ -575,6 +598,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_route_table_association":                      resourceAwsRouteTableAssociation(),
 			"aws_secretsmanager_secret":                        resourceAwsSecretsManagerSecret(),
 			"aws_secretsmanager_secret_version":                resourceAwsSecretsManagerSecretVersion(),
+			"aws_serverlessapplicationrepository_application":  resourceAwsServerlessApplicationRepositoryApplication(),
 			"aws_ses_active_receipt_rule_set":                  resourceAwsSesActiveReceiptRuleSet(),
 			"aws_ses_domain_identity":                          resourceAwsSesDomainIdentity(),
 			"aws_ses_domain_identity_verification":             resourceAwsSesDomainIdentityVerification(),
@@ -646,6 +670,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_vpc":                                          resourceAwsVpc(),
 			"aws_vpc_endpoint":                                 resourceAwsVpcEndpoint(),
 			"aws_vpc_endpoint_connection_notification":         resourceAwsVpcEndpointConnectionNotification(),
+			"aws_vpc_endpoint_policy":                          resourceAwsVpcEndpointPolicy(),
 			"aws_vpc_endpoint_route_table_association":         resourceAwsVpcEndpointRouteTableAssociation(),
 			"aws_vpc_endpoint_subnet_association":              resourceAwsVpcEndpointSubnetAssociation(),
 			"aws_vpc_endpoint_service":                         resourceAwsVpcEndpointService(),
@@ -713,8 +738,26 @@ func Provider() terraform.ResourceProvider {
 			"aws_alb_target_group_attachment": resourceAwsLbTargetGroupAttachment(),
 			"aws_lb_target_group_attachment":  resourceAwsLbTargetGroupAttachment(),
 		},
-		ConfigureFunc: providerConfigure,
 	}
+
+	// Keep the timeouts declared by each resource so that provider level
+	// defaults are always applied on top of them, even when reconfigured.
+	resourceTimeouts := make(map[string]schema.ResourceTimeout)
+	for name, r := range provider.ResourcesMap {
+		if r.Timeouts != nil {
+			resourceTimeouts[name] = *r.Timeouts
+		}
+	}
+
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		if err := applyProviderDefaultTimeouts(provider.ResourcesMap, resourceTimeouts, d.Get("default_timeouts").([]interface{})); err != nil {
+			return nil, err
+		}
+
+		return providerConfigure(d)
+	}
+
+	return provider
 }
 
 var descriptions map[string]string
@@ -818,6 +861,9 @@ func init() {
 		"assume_role_external_id": "The external ID to use when assuming the role. If omitted," +
 			" no external ID is passed to the AssumeRole call.",
 
+		"default_timeouts": "Overrides the default create, update and delete timeouts of the\n" +
+			"matching resource types. Timeouts set in a resource's `timeouts` block still take precedence.",
+
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use," +
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
@@ -946,6 +992,124 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func defaultTimeoutsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: descriptions["default_timeouts"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_types": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				"create": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateDuration,
+				},
+
+				"update": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateDuration,
+				},
+
+				"delete": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateDuration,
+				},
+			},
+		},
+	}
+}
+
+// applyProviderDefaultTimeouts resets the timeouts of all resources to the
+// ones they declare and then applies the provider level default_timeouts
+// blocks in order. A block applies to the resource types listed in
+// resource_types, where a trailing "*" matches any suffix, or to all resources
+// if none are listed. Only timeouts supported by a resource are changed.
+func applyProviderDefaultTimeouts(resources map[string]*schema.Resource, declared map[string]schema.ResourceTimeout, blocks []interface{}) error {
+	for name, timeouts := range declared {
+		t := timeouts
+		resources[name].Timeouts = &t
+	}
+
+	for _, raw := range blocks {
+		if raw == nil {
+			continue
+		}
+		block := raw.(map[string]interface{})
+
+		create, err := parseProviderDefaultTimeout(block, "create")
+		if err != nil {
+			return err
+		}
+		update, err := parseProviderDefaultTimeout(block, "update")
+		if err != nil {
+			return err
+		}
+		del, err := parseProviderDefaultTimeout(block, "delete")
+		if err != nil {
+			return err
+		}
+
+		resourceTypes := expandStringList(block["resource_types"].([]interface{}))
+		for name, r := range resources {
+			if r.Timeouts == nil || !providerDefaultTimeoutsMatch(resourceTypes, name) {
+				continue
+			}
+			if create != nil && r.Timeouts.Create != nil {
+				r.Timeouts.Create = create
+			}
+			if update != nil && r.Timeouts.Update != nil {
+				r.Timeouts.Update = update
+			}
+			if del != nil && r.Timeouts.Delete != nil {
+				r.Timeouts.Delete = del
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseProviderDefaultTimeout(block map[string]interface{}, key string) (*time.Duration, error) {
+	v := block[key].(string)
+	if v == "" {
+		return nil, nil
+	}
+
+	timeout, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing default_timeouts %s (%s): %s", key, v, err)
+	}
+
+	return &timeout, nil
+}
+
+func providerDefaultTimeoutsMatch(resourceTypes []*string, name string) bool {
+	if len(resourceTypes) == 0 {
+		return true
+	}
+
+	for _, v := range resourceTypes {
+		resourceType := aws.StringValue(v)
+		if strings.HasSuffix(resourceType, "*") {
+			if strings.HasPrefix(name, strings.TrimSuffix(resourceType, "*")) {
+				return true
+			}
+		} else if resourceType == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func endpointsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/service/organizations"
 
@@ -57,6 +60,84 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestApplyProviderDefaultTimeouts(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"aws_db_instance": {
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(40 * time.Minute),
+				Update: schema.DefaultTimeout(80 * time.Minute),
+				Delete: schema.DefaultTimeout(40 * time.Minute),
+			},
+		},
+		"aws_db_snapshot": {
+			Timeouts: &schema.ResourceTimeout{
+				Read: schema.DefaultTimeout(20 * time.Minute),
+			},
+		},
+		"aws_instance": {
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(10 * time.Minute),
+				Delete: schema.DefaultTimeout(20 * time.Minute),
+			},
+		},
+		"aws_vpc": {},
+	}
+	declared := map[string]schema.ResourceTimeout{}
+	for name, r := range resources {
+		if r.Timeouts != nil {
+			declared[name] = *r.Timeouts
+		}
+	}
+
+	blocks := []interface{}{
+		map[string]interface{}{
+			"resource_types": []interface{}{},
+			"create":         "",
+			"update":         "",
+			"delete":         "1h",
+		},
+		map[string]interface{}{
+			"resource_types": []interface{}{"aws_db_*"},
+			"create":         "2h",
+			"update":         "",
+			"delete":         "",
+		},
+	}
+
+	if err := applyProviderDefaultTimeouts(resources, declared, blocks); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]map[string]time.Duration{
+		"aws_db_instance": {"create": 2 * time.Hour, "update": 80 * time.Minute, "delete": time.Hour},
+		"aws_instance":    {"create": 10 * time.Minute, "delete": time.Hour},
+	}
+	for name, timeouts := range expected {
+		rt := resources[name].Timeouts
+		actual := map[string]time.Duration{"create": *rt.Create, "delete": *rt.Delete}
+		if rt.Update != nil {
+			actual["update"] = *rt.Update
+		}
+		if !reflect.DeepEqual(actual, timeouts) {
+			t.Fatalf("expected %s timeouts %v, got %v", name, timeouts, actual)
+		}
+	}
+	if rt := resources["aws_db_snapshot"].Timeouts; rt.Create != nil || rt.Delete != nil || *rt.Read != 20*time.Minute {
+		t.Fatalf("unexpected aws_db_snapshot timeouts: %#v", rt)
+	}
+	if resources["aws_vpc"].Timeouts != nil {
+		t.Fatalf("unexpected aws_vpc timeouts: %#v", resources["aws_vpc"].Timeouts)
+	}
+
+	// Reconfiguring without defaults restores the declared timeouts.
+	if err := applyProviderDefaultTimeouts(resources, declared, nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v := *resources["aws_db_instance"].Timeouts.Create; v != 40*time.Minute {
+		t.Fatalf("expected aws_db_instance create timeout to be reset to 40m, got %s", v)
+	}
+}
+
 func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
@@ -176,6 +257,42 @@ func testAccGetServiceEndpoint(service string) string {
 	return strings.TrimPrefix(endpoint.URL, "https://")
 }
 
+// testAccRunSerialTests runs groups of acceptance tests that can't run in
+// parallel, e.g. because they manage an account-wide singleton or the service
+// is heavily rate limited. Groups and tests run in name order, waiting delay
+// between consecutive tests.
+func testAccRunSerialTests(t *testing.T, testCases map[string]map[string]func(t *testing.T), delay time.Duration) {
+	groups := make([]string, 0, len(testCases))
+	for group := range testCases {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	first := true
+	for _, group := range groups {
+		m := testCases[group]
+		names := make([]string, 0, len(m))
+		for name := range m {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		t.Run(group, func(t *testing.T) {
+			for _, name := range names {
+				tc := m[name]
+				if !first && delay > 0 {
+					time.Sleep(delay)
+				}
+				first = false
+
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
 func testAccEC2ClassicPreCheck(t *testing.T) {
 	client := testAccProvider.Meta().(*AWSClient)
 	platforms := client.supportedplatforms
@@ -186,11 +303,24 @@ func testAccEC2ClassicPreCheck(t *testing.T) {
 	}
 }
 
+// testAccHasServicePreCheck skips the test when the endpoint metadata of the
+// AWS SDK does not list the service in the test partition or, for regional
+// services, in the test region. The service is the endpoints package ID, e.g.
+// endpoints.DaxServiceID.
 func testAccHasServicePreCheck(service string, t *testing.T) {
-	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), testAccGetRegion()); ok {
-		if _, ok := partition.Services()[service]; !ok {
+	region := testAccGetRegion()
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		s, ok := partition.Services()[service]
+		if !ok {
 			t.Skip(fmt.Sprintf("skipping tests; partition does not support %s service", service))
 		}
+
+		// Global services, e.g. CloudFront, are not listed per region.
+		if regions := s.Regions(); len(regions) > 0 {
+			if _, ok := regions[region]; !ok {
+				t.Skip(fmt.Sprintf("skipping tests; region %s does not support %s service", region, service))
+			}
+		}
 	}
 }
 
@@ -388,8 +388,13 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	d.Set("ebs_block_device", ebsBlockDevs)
-	d.Set("ephemeral_block_device", ephemeralBlockDevs)
+	if err := d.Set("ebs_block_device", ebsBlockDevs); err != nil {
+		return fmt.Errorf("error setting ebs_block_device: %s", err)
+	}
+
+	if err := d.Set("ephemeral_block_device", ephemeralBlockDevs); err != nil {
+		return fmt.Errorf("error setting ephemeral_block_device: %s", err)
+	}
 
 	d.Set("tags", tagsToMap(image.Tags))
 
@@ -516,13 +521,47 @@ func resourceAwsAmiWaitForDestroy(timeout time.Duration, id string, client *ec2.
 	return nil
 }
 
+// resourceAwsAmiLogSnapshotProgress logs the progress of the EBS snapshots
+// backing a pending AMI, as creating or copying large images can take hours.
+func resourceAwsAmiLogSnapshotProgress(client *ec2.EC2, image *ec2.Image) {
+	var snapshotIds []*string
+	for _, bdm := range image.BlockDeviceMappings {
+		if bdm.Ebs != nil && bdm.Ebs.SnapshotId != nil {
+			snapshotIds = append(snapshotIds, bdm.Ebs.SnapshotId)
+		}
+	}
+	if len(snapshotIds) == 0 {
+		return
+	}
+
+	resp, err := client.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+		SnapshotIds: snapshotIds,
+	})
+	if err != nil {
+		log.Printf("[DEBUG] Error reading progress of AMI (%s) snapshots: %s", aws.StringValue(image.ImageId), err)
+		return
+	}
+
+	for _, snapshot := range resp.Snapshots {
+		log.Printf("[INFO] AMI (%s) snapshot %s: %s (%s)", aws.StringValue(image.ImageId),
+			aws.StringValue(snapshot.SnapshotId), aws.StringValue(snapshot.State), aws.StringValue(snapshot.Progress))
+	}
+}
+
 func resourceAwsAmiWaitForAvailable(timeout time.Duration, id string, client *ec2.EC2) (*ec2.Image, error) {
 	log.Printf("Waiting for AMI %s to become available...", id)
 
+	refresh := AMIStateRefreshFunc(client, id)
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"pending"},
-		Target:     []string{"available"},
-		Refresh:    AMIStateRefreshFunc(client, id),
+		Pending: []string{"pending"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			info, state, err := refresh()
+			if err == nil && state == "pending" {
+				resourceAwsAmiLogSnapshotProgress(client, info.(*ec2.Image))
+			}
+			return info, state, err
+		},
 		Timeout:    timeout,
 		Delay:      AWSAMIRetryDelay,
 		MinTimeout: AWSAMIRetryMinTimeout,
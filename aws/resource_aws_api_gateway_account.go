@@ -26,6 +26,11 @@ func resourceAwsApiGatewayAccount() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"reset_on_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"throttle_settings": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -121,6 +126,29 @@ func resourceAwsApiGatewayAccountUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsApiGatewayAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	// There is no API for "deleting" account or resetting it to "default" settings
+	// There is no API for "deleting" account settings, so by default they are
+	// retained. The CloudWatch role is the only setting that can be reset.
+	if !d.Get("reset_on_delete").(bool) {
+		log.Printf("[DEBUG] Retaining API Gateway Account settings")
+		return nil
+	}
+
+	conn := meta.(*AWSClient).apigateway
+
+	input := &apigateway.UpdateAccountInput{
+		PatchOperations: []*apigateway.PatchOperation{
+			{
+				Op:    aws.String("replace"),
+				Path:  aws.String("/cloudwatchRoleArn"),
+				Value: aws.String(""),
+			},
+		},
+	}
+
+	log.Printf("[INFO] Resetting API Gateway Account CloudWatch role: %s", input)
+	if _, err := conn.UpdateAccount(input); err != nil {
+		return fmt.Errorf("error resetting API Gateway Account CloudWatch role: %s", err)
+	}
+
 	return nil
 }
@@ -24,9 +24,10 @@ func TestAccAWSAPIGatewayAccount_importBasic(t *testing.T) {
 			},
 
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reset_on_delete"},
 			},
 		},
 	})
@@ -74,6 +75,48 @@ func TestAccAWSAPIGatewayAccount_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSAPIGatewayAccount_resetOnDelete(t *testing.T) {
+	var conf apigateway.Account
+
+	rName := fmt.Sprintf("tf_acc_api_gateway_cloudwatch_%d", acctest.RandInt())
+	expectedRoleArn := regexp.MustCompile(":role/" + rName + "$")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayAccountConfig_resetOnDelete(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayAccountExists("aws_api_gateway_account.test", &conf),
+					testAccCheckAWSAPIGatewayAccountCloudwatchRoleArn(&conf, expectedRoleArn),
+					resource.TestCheckResourceAttr("aws_api_gateway_account.test", "reset_on_delete", "true"),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayAccountConfig_cloudwatchRole(rName),
+				Check:  testAccCheckAWSAPIGatewayAccountCloudwatchRoleArnReset,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayAccountCloudwatchRoleArnReset(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigateway
+
+	account, err := conn.GetAccount(&apigateway.GetAccountInput{})
+	if err != nil {
+		return err
+	}
+
+	if account.CloudwatchRoleArn != nil && *account.CloudwatchRoleArn != "" {
+		return fmt.Errorf("Expected empty CloudwatchRoleArn, given: %q", *account.CloudwatchRoleArn)
+	}
+
+	return nil
+}
+
 func testAccCheckAWSAPIGatewayAccountCloudwatchRoleArn(conf *apigateway.Account, expectedArn *regexp.Regexp) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if expectedArn == nil && conf.CloudwatchRoleArn == nil {
@@ -132,11 +175,26 @@ resource "aws_api_gateway_account" "test" {
 `
 
 func testAccAWSAPIGatewayAccountConfig_updated(randName string) string {
-	return fmt.Sprintf(`
+	return testAccAWSAPIGatewayAccountConfig_cloudwatchRole(randName) + `
+resource "aws_api_gateway_account" "test" {
+  cloudwatch_role_arn = "${aws_iam_role.cloudwatch.arn}"
+}
+`
+}
+
+func testAccAWSAPIGatewayAccountConfig_resetOnDelete(randName string) string {
+	return testAccAWSAPIGatewayAccountConfig_cloudwatchRole(randName) + `
 resource "aws_api_gateway_account" "test" {
   cloudwatch_role_arn = "${aws_iam_role.cloudwatch.arn}"
+  reset_on_delete     = true
+
+  depends_on = ["aws_iam_role_policy.cloudwatch"]
+}
+`
 }
 
+func testAccAWSAPIGatewayAccountConfig_cloudwatchRole(randName string) string {
+	return fmt.Sprintf(`
 resource "aws_iam_role" "cloudwatch" {
     name = "%s"
     assume_role_policy = <<EOF
@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsApiGatewayApiKeysImport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayApiKeysImportCreate,
+		Read:   resourceAwsApiGatewayApiKeysImportRead,
+		Delete: resourceAwsApiGatewayApiKeysImportDelete,
+
+		Schema: map[string]*schema.Schema{
+			"api_key": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(30, 128),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "Managed by Terraform",
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+						"usage_plan_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"fail_on_warnings": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsApiGatewayApiKeysImportCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	body, err := expandApiGatewayApiKeysImportCsv(d.Get("api_key").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("error rendering API Gateway API Keys import: %s", err)
+	}
+
+	input := &apigateway.ImportApiKeysInput{
+		Body:           body,
+		FailOnWarnings: aws.Bool(d.Get("fail_on_warnings").(bool)),
+		Format:         aws.String(apigateway.ApiKeysFormatCsv),
+	}
+
+	log.Printf("[DEBUG] Importing API Gateway API Keys (fail on warnings: %t)", d.Get("fail_on_warnings").(bool))
+	out, err := conn.ImportApiKeys(input)
+	if err != nil {
+		return fmt.Errorf("error importing API Gateway API Keys: %s", err)
+	}
+
+	for _, w := range out.Warnings {
+		log.Printf("[WARN] API Gateway API Keys import: %s", aws.StringValue(w))
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("ids", flattenStringList(out.Ids))
+	d.Set("warnings", flattenStringList(out.Warnings))
+
+	return resourceAwsApiGatewayApiKeysImportRead(d, meta)
+}
+
+func resourceAwsApiGatewayApiKeysImportRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	ids := make([]string, 0)
+	for _, v := range d.Get("ids").([]interface{}) {
+		id := v.(string)
+		_, err := conn.GetApiKey(&apigateway.GetApiKeyInput{
+			ApiKey: aws.String(id),
+		})
+		if isAWSErr(err, apigateway.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] API Gateway API Key (%s) not found", id)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error reading API Gateway API Key (%s): %s", id, err)
+		}
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 {
+		log.Printf("[WARN] No API Gateway API Keys of import (%s) found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("ids", ids)
+
+	return nil
+}
+
+func resourceAwsApiGatewayApiKeysImportDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	for _, v := range d.Get("ids").([]interface{}) {
+		id := v.(string)
+		log.Printf("[DEBUG] Deleting API Gateway API Key: %s", id)
+		_, err := conn.DeleteApiKey(&apigateway.DeleteApiKeyInput{
+			ApiKey: aws.String(id),
+		})
+		if isAWSErr(err, apigateway.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error deleting API Gateway API Key (%s): %s", id, err)
+		}
+	}
+
+	return nil
+}
+
+// expandApiGatewayApiKeysImportCsv renders api_key blocks in the CSV format
+// expected by ImportApiKeys.
+func expandApiGatewayApiKeysImportCsv(l []interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write([]string{"name", "key", "description", "enabled", "usageplanIds"}); err != nil {
+		return nil, err
+	}
+
+	for _, v := range l {
+		m := v.(map[string]interface{})
+
+		planIds := make([]string, 0)
+		for _, id := range m["usage_plan_ids"].([]interface{}) {
+			planIds = append(planIds, id.(string))
+		}
+
+		record := []string{
+			m["name"].(string),
+			m["value"].(string),
+			m["description"].(string),
+			strconv.FormatBool(m["enabled"].(bool)),
+			strings.Join(planIds, ","),
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
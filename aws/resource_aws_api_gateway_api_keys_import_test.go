@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandApiGatewayApiKeysImportCsv(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name":           "first",
+			"value":          "abcdefghijklmnopqrstuvwxyz0123456789",
+			"description":    "Managed by Terraform",
+			"enabled":        true,
+			"usage_plan_ids": []interface{}{},
+		},
+		map[string]interface{}{
+			"name":           "second",
+			"value":          "0123456789abcdefghijklmnopqrstuvwxyz",
+			"description":    "Partner, read-only",
+			"enabled":        false,
+			"usage_plan_ids": []interface{}{"plan1", "plan2"},
+		},
+	}
+
+	expected := `name,key,description,enabled,usageplanIds
+first,abcdefghijklmnopqrstuvwxyz0123456789,Managed by Terraform,true,
+second,0123456789abcdefghijklmnopqrstuvwxyz,"Partner, read-only",false,"plan1,plan2"
+`
+
+	got, err := expandApiGatewayApiKeysImportCsv(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if string(got) != expected {
+		t.Fatalf("got %q, expected %q", string(got), expected)
+	}
+}
+
+func TestAccAWSAPIGatewayApiKeysImport_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_api_gateway_api_keys_import.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayApiKeysImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayApiKeysImportConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayApiKeysImportExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "api_key.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayApiKeysImportExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway API Keys import ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigateway
+
+		for k, id := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "ids.") || k == "ids.#" {
+				continue
+			}
+			if _, err := conn.GetApiKey(&apigateway.GetApiKeyInput{ApiKey: aws.String(id)}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAPIGatewayApiKeysImportDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigateway
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_api_gateway_api_keys_import" {
+			continue
+		}
+
+		for k, id := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "ids.") || k == "ids.#" {
+				continue
+			}
+			_, err := conn.GetApiKey(&apigateway.GetApiKeyInput{ApiKey: aws.String(id)})
+			if err == nil {
+				return fmt.Errorf("API Gateway API Key (%s) still exists", id)
+			}
+			if !isAWSErr(err, apigateway.ErrCodeNotFoundException, "") {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSAPIGatewayApiKeysImportConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_api_keys_import" "test" {
+  fail_on_warnings = true
+
+  api_key {
+    name  = "%[1]s-1"
+    value = "%[1]s-value-0000000000000000001"
+  }
+
+  api_key {
+    name        = "%[1]s-2"
+    value       = "%[1]s-value-0000000000000000002"
+    description = "Disabled partner key"
+    enabled     = false
+  }
+}
+`, rName)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -50,6 +51,42 @@ func resourceAwsApiGatewayDeployment() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"canary_deployment": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"percent_traffic": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"stage_variable_overrides": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"use_stage_cache": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+						"alarm_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"promote": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"created_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -78,14 +115,20 @@ func resourceAwsApiGatewayDeploymentCreate(d *schema.ResourceData, meta interfac
 		variables[k] = v.(string)
 	}
 
-	var err error
-	deployment, err := conn.CreateDeployment(&apigateway.CreateDeploymentInput{
+	input := &apigateway.CreateDeploymentInput{
 		RestApiId:        aws.String(d.Get("rest_api_id").(string)),
 		StageName:        aws.String(d.Get("stage_name").(string)),
 		Description:      aws.String(d.Get("description").(string)),
 		StageDescription: aws.String(d.Get("stage_description").(string)),
 		Variables:        aws.StringMap(variables),
-	})
+	}
+
+	if v, ok := d.GetOk("canary_deployment"); ok {
+		input.CanarySettings = expandApiGatewayDeploymentCanarySettings(v.([]interface{}))
+	}
+
+	var err error
+	deployment, err := conn.CreateDeployment(input)
 	if err != nil {
 		return fmt.Errorf("Error creating API Gateway Deployment: %s", err)
 	}
@@ -93,6 +136,15 @@ func resourceAwsApiGatewayDeploymentCreate(d *schema.ResourceData, meta interfac
 	d.SetId(*deployment.Id)
 	log.Printf("[DEBUG] API Gateway Deployment ID: %s", d.Id())
 
+	// Promotion is an update-time operation against a canary that is already
+	// receiving traffic, so a canary created with promote = true is promoted
+	// immediately after creation.
+	if d.Get("canary_deployment.0.promote").(bool) {
+		if err := resourceAwsApiGatewayDeploymentPromoteCanary(d, meta); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsApiGatewayDeploymentRead(d, meta)
 }
 
@@ -134,6 +186,32 @@ func resourceAwsApiGatewayDeploymentRead(d *schema.ResourceData, meta interface{
 		log.Printf("[DEBUG] Error setting created_date: %s", err)
 	}
 
+	if _, ok := d.GetOk("canary_deployment"); ok {
+		stage, err := conn.GetStage(&apigateway.GetStageInput{
+			RestApiId: aws.String(restApiId),
+			StageName: aws.String(stageName),
+		})
+		if err != nil && !isAWSErr(err, apigateway.ErrCodeNotFoundException, "") {
+			return fmt.Errorf("error getting API Gateway Stage (%s): %s", stageName, err)
+		}
+
+		// Only refresh the canary settings while this deployment is the active
+		// canary. Once promoted the stage no longer carries them, and once
+		// rolled back or removed the canary is gone, so it is cleared to
+		// re-create the canary on the next apply.
+		switch {
+		case stage != nil && stage.CanarySettings != nil && aws.StringValue(stage.CanarySettings.DeploymentId) == d.Id():
+			if err := d.Set("canary_deployment", flattenApiGatewayDeploymentCanarySettings(stage.CanarySettings, d)); err != nil {
+				return fmt.Errorf("error setting canary_deployment: %s", err)
+			}
+		case stage != nil && aws.StringValue(stage.DeploymentId) == d.Id():
+			log.Printf("[DEBUG] API Gateway Deployment (%s) canary has been promoted", d.Id())
+		default:
+			log.Printf("[WARN] API Gateway Deployment (%s) is no longer the canary of stage %q, removing canary_deployment from state", d.Id(), stageName)
+			d.Set("canary_deployment", []interface{}{})
+		}
+	}
+
 	return nil
 }
 
@@ -156,16 +234,132 @@ func resourceAwsApiGatewayDeploymentUpdate(d *schema.ResourceData, meta interfac
 
 	log.Printf("[DEBUG] Updating API Gateway API Key: %s", d.Id())
 
-	_, err := conn.UpdateDeployment(&apigateway.UpdateDeploymentInput{
-		DeploymentId:    aws.String(d.Id()),
-		RestApiId:       aws.String(d.Get("rest_api_id").(string)),
-		PatchOperations: resourceAwsApiGatewayDeploymentUpdateOperations(d),
+	if d.HasChange("description") {
+		_, err := conn.UpdateDeployment(&apigateway.UpdateDeploymentInput{
+			DeploymentId:    aws.String(d.Id()),
+			RestApiId:       aws.String(d.Get("rest_api_id").(string)),
+			PatchOperations: resourceAwsApiGatewayDeploymentUpdateOperations(d),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	promote := d.Get("canary_deployment.0.promote").(bool)
+
+	if d.HasChange("canary_deployment.0.percent_traffic") && !promote {
+		_, err := conn.UpdateStage(&apigateway.UpdateStageInput{
+			RestApiId: aws.String(d.Get("rest_api_id").(string)),
+			StageName: aws.String(d.Get("stage_name").(string)),
+			PatchOperations: []*apigateway.PatchOperation{
+				{
+					Op:    aws.String("replace"),
+					Path:  aws.String("/canarySettings/percentTraffic"),
+					Value: aws.String(fmt.Sprintf("%g", d.Get("canary_deployment.0.percent_traffic").(float64))),
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error updating API Gateway Deployment (%s) canary traffic: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("canary_deployment.0.promote") && promote {
+		if err := resourceAwsApiGatewayDeploymentPromoteCanary(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsApiGatewayDeploymentRead(d, meta)
+}
+
+// resourceAwsApiGatewayDeploymentPromoteCanary promotes the canary deployment
+// to the stage's primary deployment. When an alarm is referenced and it is in
+// the ALARM state, the canary is rolled back instead and an error is returned.
+func resourceAwsApiGatewayDeploymentPromoteCanary(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+	restApiId := d.Get("rest_api_id").(string)
+	stageName := d.Get("stage_name").(string)
+
+	stage, err := conn.GetStage(&apigateway.GetStageInput{
+		RestApiId: aws.String(restApiId),
+		StageName: aws.String(stageName),
 	})
 	if err != nil {
-		return err
+		return fmt.Errorf("error getting API Gateway Stage (%s): %s", stageName, err)
 	}
 
-	return resourceAwsApiGatewayDeploymentRead(d, meta)
+	if stage.CanarySettings == nil || aws.StringValue(stage.CanarySettings.DeploymentId) != d.Id() {
+		return fmt.Errorf("error promoting API Gateway Deployment (%s): deployment is no longer the canary of stage %q", d.Id(), stageName)
+	}
+
+	if alarmName, ok := d.GetOk("canary_deployment.0.alarm_name"); ok {
+		state, err := apiGatewayDeploymentCanaryAlarmState(meta.(*AWSClient).cloudwatchconn, alarmName.(string))
+		if err != nil {
+			return err
+		}
+
+		if state == cloudwatch.StateValueAlarm {
+			log.Printf("[DEBUG] Rolling back API Gateway Deployment (%s) canary, alarm %q is in %s state", d.Id(), alarmName, state)
+			_, err := conn.UpdateStage(&apigateway.UpdateStageInput{
+				RestApiId: aws.String(restApiId),
+				StageName: aws.String(stageName),
+				PatchOperations: []*apigateway.PatchOperation{
+					{
+						Op:   aws.String("remove"),
+						Path: aws.String("/canarySettings"),
+					},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("error rolling back API Gateway Deployment (%s) canary: %s", d.Id(), err)
+			}
+
+			// Keep the previous promote value in state so the failed promotion
+			// is still visible as a difference on the next plan.
+			d.Partial(true)
+			return fmt.Errorf("API Gateway Deployment (%s) canary rolled back: CloudWatch alarm %q is in %s state", d.Id(), alarmName, state)
+		}
+	}
+
+	log.Printf("[DEBUG] Promoting API Gateway Deployment (%s) canary on stage %q", d.Id(), stageName)
+	_, err = conn.UpdateStage(&apigateway.UpdateStageInput{
+		RestApiId: aws.String(restApiId),
+		StageName: aws.String(stageName),
+		PatchOperations: []*apigateway.PatchOperation{
+			{
+				Op:    aws.String("replace"),
+				Path:  aws.String("/deploymentId"),
+				Value: aws.String(d.Id()),
+			},
+			{
+				Op:   aws.String("remove"),
+				Path: aws.String("/canarySettings"),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error promoting API Gateway Deployment (%s) canary: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func apiGatewayDeploymentCanaryAlarmState(conn *cloudwatch.CloudWatch, alarmName string) (string, error) {
+	resp, err := conn.DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+		AlarmNames: []*string{aws.String(alarmName)},
+	})
+	if err != nil {
+		return "", fmt.Errorf("error describing CloudWatch Alarm (%s): %s", alarmName, err)
+	}
+
+	for _, alarm := range resp.MetricAlarms {
+		if aws.StringValue(alarm.AlarmName) == alarmName {
+			return aws.StringValue(alarm.StateValue), nil
+		}
+	}
+
+	return "", fmt.Errorf("error describing CloudWatch Alarm (%s): not found", alarmName)
 }
 
 func resourceAwsApiGatewayDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
@@ -189,6 +383,23 @@ func resourceAwsApiGatewayDeploymentDelete(d *schema.ResourceData, meta interfac
 		shouldDeleteStage = true
 	}
 
+	// A deployment referenced as the stage canary cannot be deleted.
+	if stage != nil && stage.CanarySettings != nil && aws.StringValue(stage.CanarySettings.DeploymentId) == d.Id() {
+		_, err := conn.UpdateStage(&apigateway.UpdateStageInput{
+			StageName: aws.String(d.Get("stage_name").(string)),
+			RestApiId: aws.String(d.Get("rest_api_id").(string)),
+			PatchOperations: []*apigateway.PatchOperation{
+				{
+					Op:   aws.String("remove"),
+					Path: aws.String("/canarySettings"),
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error removing API Gateway Deployment (%s) canary settings: %s", d.Id(), err)
+		}
+	}
+
 	if shouldDeleteStage {
 		if _, err := conn.DeleteStage(&apigateway.DeleteStageInput{
 			StageName: aws.String(d.Get("stage_name").(string)),
@@ -213,3 +424,38 @@ func resourceAwsApiGatewayDeploymentDelete(d *schema.ResourceData, meta interfac
 
 	return nil
 }
+
+func expandApiGatewayDeploymentCanarySettings(l []interface{}) *apigateway.DeploymentCanarySettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	settings := &apigateway.DeploymentCanarySettings{
+		PercentTraffic: aws.Float64(m["percent_traffic"].(float64)),
+		UseStageCache:  aws.Bool(m["use_stage_cache"].(bool)),
+	}
+
+	if v, ok := m["stage_variable_overrides"].(map[string]interface{}); ok && len(v) > 0 {
+		settings.StageVariableOverrides = stringMapToPointers(v)
+	}
+
+	return settings
+}
+
+func flattenApiGatewayDeploymentCanarySettings(settings *apigateway.CanarySettings, d *schema.ResourceData) []interface{} {
+	if settings == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"percent_traffic":          aws.Float64Value(settings.PercentTraffic),
+		"stage_variable_overrides": aws.StringValueMap(settings.StageVariableOverrides),
+		"use_stage_cache":          aws.BoolValue(settings.UseStageCache),
+		"alarm_name":               d.Get("canary_deployment.0.alarm_name").(string),
+		"promote":                  d.Get("canary_deployment.0.promote").(bool),
+	}
+
+	return []interface{}{m}
+}
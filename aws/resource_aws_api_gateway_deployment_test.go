@@ -80,6 +80,102 @@ func TestAccAWSAPIGatewayDeployment_createBeforeDestoryUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAWSAPIGatewayDeployment_canaryDeployment(t *testing.T) {
+	var conf apigateway.Deployment
+	var restApi apigateway.RestApi
+	var stage apigateway.Stage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayDeploymentCanaryConfig(10, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayDeploymentExists("aws_api_gateway_deployment.canary", &conf),
+					testAccCheckAWSAPIGatewayDeploymentStageExists("aws_api_gateway_deployment.canary", &stage),
+					testAccCheckAWSAPIGatewayDeploymentCanaryActive(&stage, &conf),
+					resource.TestCheckResourceAttr("aws_api_gateway_deployment.canary", "canary_deployment.#", "1"),
+					resource.TestCheckResourceAttr("aws_api_gateway_deployment.canary", "canary_deployment.0.percent_traffic", "10"),
+					resource.TestCheckResourceAttr("aws_api_gateway_deployment.canary", "canary_deployment.0.stage_variable_overrides.a", "3"),
+					resource.TestCheckResourceAttr("aws_api_gateway_deployment.canary", "canary_deployment.0.use_stage_cache", "false"),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayDeploymentCanaryConfig(25, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayRestAPIExists("aws_api_gateway_rest_api.test", &restApi),
+					testAccCheckAWSAPIGatewayDeploymentExists("aws_api_gateway_deployment.canary", &conf),
+					testAccCheckAWSAPIGatewayDeploymentStageExists("aws_api_gateway_deployment.canary", &stage),
+					testAccCheckAWSAPIGatewayDeploymentCanaryActive(&stage, &conf),
+					resource.TestCheckResourceAttr("aws_api_gateway_deployment.canary", "canary_deployment.0.percent_traffic", "25"),
+				),
+			},
+			{
+				// Simulate a canary rolled back by an alarm, the next plan
+				// must re-create it.
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*AWSClient).apigateway
+					_, err := conn.UpdateStage(&apigateway.UpdateStageInput{
+						RestApiId: restApi.Id,
+						StageName: stage.StageName,
+						PatchOperations: []*apigateway.PatchOperation{
+							{
+								Op:   aws.String("remove"),
+								Path: aws.String("/canarySettings"),
+							},
+						},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccAWSAPIGatewayDeploymentCanaryConfig(25, false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSAPIGatewayDeploymentCanaryConfig(25, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayDeploymentExists("aws_api_gateway_deployment.canary", &conf),
+					testAccCheckAWSAPIGatewayDeploymentStageExists("aws_api_gateway_deployment.canary", &stage),
+					testAccCheckAWSAPIGatewayDeploymentCanaryPromoted(&stage, &conf),
+					resource.TestCheckResourceAttr("aws_api_gateway_deployment.canary", "canary_deployment.0.promote", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayDeploymentCanaryActive(stage *apigateway.Stage, deployment *apigateway.Deployment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if stage.CanarySettings == nil {
+			return fmt.Errorf("API Gateway Stage has no canary settings")
+		}
+
+		if got, want := aws.StringValue(stage.CanarySettings.DeploymentId), aws.StringValue(deployment.Id); got != want {
+			return fmt.Errorf("expected canary deployment %q, got %q", want, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAPIGatewayDeploymentCanaryPromoted(stage *apigateway.Stage, deployment *apigateway.Deployment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if stage.CanarySettings != nil {
+			return fmt.Errorf("API Gateway Stage still has canary settings: %s", stage.CanarySettings)
+		}
+
+		if got, want := aws.StringValue(stage.DeploymentId), aws.StringValue(deployment.Id); got != want {
+			return fmt.Errorf("expected stage deployment %q, got %q", want, got)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSAPIGatewayDeploymentExists(n string, res *apigateway.Deployment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -239,3 +335,40 @@ func testAccAWSAPIGatewayDeploymentCreateBeforeDestroyConfig(description string,
 		}
 	`)
 }
+
+func testAccAWSAPIGatewayDeploymentCanaryConfig(percentTraffic int, promote bool) string {
+	return buildAPIGatewayDeploymentConfig("This is a test", "https://www.google.de", "") + fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = "tf-acc-test-api-gateway-canary"
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = "1"
+  metric_name         = "5XXError"
+  namespace           = "AWS/ApiGateway"
+  period              = "60"
+  statistic           = "Sum"
+  threshold           = "1"
+  treat_missing_data  = "notBreaching"
+
+  dimensions {
+    ApiName = "${aws_api_gateway_rest_api.test.name}"
+    Stage   = "${aws_api_gateway_deployment.test.stage_name}"
+  }
+}
+
+resource "aws_api_gateway_deployment" "canary" {
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  stage_name  = "${aws_api_gateway_deployment.test.stage_name}"
+  description = "canary"
+
+  canary_deployment {
+    percent_traffic = %d
+    alarm_name      = "${aws_cloudwatch_metric_alarm.test.alarm_name}"
+    promote         = %t
+
+    stage_variable_overrides = {
+      "a" = "3"
+    }
+  }
+}
+`, percentTraffic, promote)
+}
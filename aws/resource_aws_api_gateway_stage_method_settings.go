@@ -0,0 +1,325 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsApiGatewayStageMethodSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayStageMethodSettingsUpdate,
+		Read:   resourceAwsApiGatewayStageMethodSettingsRead,
+		Update: resourceAwsApiGatewayStageMethodSettingsUpdate,
+		Delete: resourceAwsApiGatewayStageMethodSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayStageMethodSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rest_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stage_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"method_setting": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      resourceAwsApiGatewayStageMethodSettingHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"metrics_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"logging_level": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "OFF",
+						},
+						"data_trace_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"throttling_burst_limit": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  -1,
+						},
+						"throttling_rate_limit": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+							Default:  -1.0,
+						},
+						"caching_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"cache_ttl_in_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  300,
+						},
+						"cache_data_encrypted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"require_authorization_for_cache_control": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"unauthorized_cache_control_header_strategy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  apigateway.UnauthorizedCacheControlHeaderStrategySucceedWithResponseHeader,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsApiGatewayStageMethodSettingsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/STAGE-NAME", d.Id())
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.Set("stage_name", idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsApiGatewayStageMethodSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	log.Printf("[DEBUG] Reading API Gateway Stage Method Settings %s", d.Id())
+	stage, err := conn.GetStage(&apigateway.GetStageInput{
+		RestApiId: aws.String(d.Get("rest_api_id").(string)),
+		StageName: aws.String(d.Get("stage_name").(string)),
+	})
+	if isAWSErr(err, apigateway.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] API Gateway Stage (%s) not found, removing method settings", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway Stage (%s): %s", d.Id(), err)
+	}
+
+	// The resource is authoritative for all method settings of the stage.
+	settings := make([]interface{}, 0, len(stage.MethodSettings))
+	for methodPath, setting := range stage.MethodSettings {
+		settings = append(settings, flattenApiGatewayStageMethodSetting(methodPath, setting))
+	}
+
+	if err := d.Set("method_setting", schema.NewSet(resourceAwsApiGatewayStageMethodSettingHash, settings)); err != nil {
+		return fmt.Errorf("error setting method_setting: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsApiGatewayStageMethodSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	restApiId := d.Get("rest_api_id").(string)
+	stageName := d.Get("stage_name").(string)
+
+	o, n := d.GetChange("method_setting")
+	ops := expandApiGatewayStageMethodSettingsPatchOperations(
+		apiGatewayStageMethodSettingsByPath(o.(*schema.Set)),
+		apiGatewayStageMethodSettingsByPath(n.(*schema.Set)),
+	)
+
+	// All method settings are changed in a single request, rather than one
+	// request per method path.
+	if len(ops) > 0 {
+		input := &apigateway.UpdateStageInput{
+			RestApiId:       aws.String(restApiId),
+			StageName:       aws.String(stageName),
+			PatchOperations: ops,
+		}
+		log.Printf("[DEBUG] Updating API Gateway Stage: %s", input)
+		if _, err := conn.UpdateStage(input); err != nil {
+			return fmt.Errorf("error updating API Gateway Stage (%s) method settings: %s", stageName, err)
+		}
+	}
+
+	d.SetId(restApiId + "/" + stageName)
+
+	return resourceAwsApiGatewayStageMethodSettingsRead(d, meta)
+}
+
+func resourceAwsApiGatewayStageMethodSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+	log.Printf("[DEBUG] Deleting API Gateway Stage Method Settings: %s", d.Id())
+
+	ops := expandApiGatewayStageMethodSettingsPatchOperations(
+		apiGatewayStageMethodSettingsByPath(d.Get("method_setting").(*schema.Set)),
+		map[string]map[string]interface{}{},
+	)
+	if len(ops) == 0 {
+		return nil
+	}
+
+	_, err := conn.UpdateStage(&apigateway.UpdateStageInput{
+		RestApiId:       aws.String(d.Get("rest_api_id").(string)),
+		StageName:       aws.String(d.Get("stage_name").(string)),
+		PatchOperations: ops,
+	})
+	if isAWSErr(err, apigateway.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway Stage (%s) method settings: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsApiGatewayStageMethodSettingHash only hashes the method path, so
+// changed settings of a method path are updated in place.
+func resourceAwsApiGatewayStageMethodSettingHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	return hashcode.String(m["method_path"].(string))
+}
+
+func apiGatewayStageMethodSettingsByPath(s *schema.Set) map[string]map[string]interface{} {
+	settings := make(map[string]map[string]interface{})
+	for _, v := range s.List() {
+		m := v.(map[string]interface{})
+		settings[m["method_path"].(string)] = m
+	}
+	return settings
+}
+
+// apiGatewayStageMethodSettingPatchPaths maps the method_setting attributes
+// to their UpdateStage patch paths, relative to the method path, and to the
+// API Gateway defaults they are reset to when removed from the configuration.
+var apiGatewayStageMethodSettingPatchPaths = []struct {
+	attribute    string
+	path         string
+	defaultValue interface{}
+}{
+	{"metrics_enabled", "metrics/enabled", false},
+	{"logging_level", "logging/loglevel", "OFF"},
+	{"data_trace_enabled", "logging/dataTrace", false},
+	{"throttling_burst_limit", "throttling/burstLimit", -1},
+	{"throttling_rate_limit", "throttling/rateLimit", -1.0},
+	{"caching_enabled", "caching/enabled", false},
+	{"cache_ttl_in_seconds", "caching/ttlInSeconds", 300},
+	{"cache_data_encrypted", "caching/dataEncrypted", false},
+	{"require_authorization_for_cache_control", "caching/requireAuthorizationForCacheControl", true},
+	{"unauthorized_cache_control_header_strategy", "caching/unauthorizedCacheControlHeaderStrategy", apigateway.UnauthorizedCacheControlHeaderStrategySucceedWithResponseHeader},
+}
+
+// expandApiGatewayStageMethodSettingsPatchOperations returns the patch
+// operations removing method paths that are no longer configured and
+// replacing the changed settings of the others. Settings removed from the
+// configuration change back to their default, which is sent like any other
+// change.
+func expandApiGatewayStageMethodSettingsPatchOperations(old, new map[string]map[string]interface{}) []*apigateway.PatchOperation {
+	ops := make([]*apigateway.PatchOperation, 0)
+
+	oldPaths := make([]string, 0, len(old))
+	for methodPath := range old {
+		oldPaths = append(oldPaths, methodPath)
+	}
+	sort.Strings(oldPaths)
+
+	for _, methodPath := range oldPaths {
+		if _, ok := new[methodPath]; !ok {
+			ops = append(ops, &apigateway.PatchOperation{
+				Op:   aws.String("remove"),
+				Path: aws.String(fmt.Sprintf("/%s", methodPath)),
+			})
+		}
+	}
+
+	newPaths := make([]string, 0, len(new))
+	for methodPath := range new {
+		newPaths = append(newPaths, methodPath)
+	}
+	sort.Strings(newPaths)
+
+	for _, methodPath := range newPaths {
+		m := new[methodPath]
+		prev, exists := old[methodPath]
+		prefix := fmt.Sprintf("/%s/", methodPath)
+
+		for _, p := range apiGatewayStageMethodSettingPatchPaths {
+			v := m[p.attribute]
+
+			// Settings of a new method path are created by its first
+			// operation, so metrics/enabled is always sent for those.
+			if exists && prev[p.attribute] == v {
+				continue
+			}
+			if !exists && p.attribute != "metrics_enabled" && v == p.defaultValue {
+				continue
+			}
+			if s, ok := v.(string); ok && s == "" {
+				continue
+			}
+
+			ops = append(ops, &apigateway.PatchOperation{
+				Op:    aws.String("replace"),
+				Path:  aws.String(prefix + p.path),
+				Value: aws.String(formatApiGatewayStageMethodSettingValue(v)),
+			})
+		}
+	}
+
+	return ops
+}
+
+func formatApiGatewayStageMethodSettingValue(v interface{}) string {
+	switch v := v.(type) {
+	case bool:
+		return fmt.Sprintf("%t", v)
+	case int:
+		return fmt.Sprintf("%d", v)
+	case float64:
+		return fmt.Sprintf("%f", v)
+	}
+	return fmt.Sprintf("%s", v)
+}
+
+func flattenApiGatewayStageMethodSetting(methodPath string, setting *apigateway.MethodSetting) map[string]interface{} {
+	return map[string]interface{}{
+		"method_path":                                methodPath,
+		"metrics_enabled":                            aws.BoolValue(setting.MetricsEnabled),
+		"logging_level":                              aws.StringValue(setting.LoggingLevel),
+		"data_trace_enabled":                         aws.BoolValue(setting.DataTraceEnabled),
+		"throttling_burst_limit":                     int(aws.Int64Value(setting.ThrottlingBurstLimit)),
+		"throttling_rate_limit":                      aws.Float64Value(setting.ThrottlingRateLimit),
+		"caching_enabled":                            aws.BoolValue(setting.CachingEnabled),
+		"cache_ttl_in_seconds":                       int(aws.Int64Value(setting.CacheTtlInSeconds)),
+		"cache_data_encrypted":                       aws.BoolValue(setting.CacheDataEncrypted),
+		"require_authorization_for_cache_control":    aws.BoolValue(setting.RequireAuthorizationForCacheControl),
+		"unauthorized_cache_control_header_strategy": aws.StringValue(setting.UnauthorizedCacheControlHeaderStrategy),
+	}
+}
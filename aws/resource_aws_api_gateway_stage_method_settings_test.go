@@ -0,0 +1,231 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayStageMethodSettings_basic(t *testing.T) {
+	var stage apigateway.Stage
+	rInt := acctest.RandInt()
+	resourceName := "aws_api_gateway_stage_method_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayStageMethodSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayStageMethodSettingsConfig(rInt, `
+  method_setting {
+    method_path     = "test/GET"
+    metrics_enabled = true
+    logging_level   = "INFO"
+  }
+
+  method_setting {
+    method_path            = "test/POST"
+    throttling_burst_limit = 10
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayMethodSettingsExists(resourceName, &stage),
+					testAccCheckAWSAPIGatewayMethodSettings_metricsEnabled(&stage, "test/GET", true),
+					testAccCheckAWSAPIGatewayMethodSettings_loggingLevel(&stage, "test/GET", "INFO"),
+					testAccCheckAWSAPIGatewayStageMethodSettingsPaths(&stage, "test/GET", "test/POST"),
+					resource.TestCheckResourceAttr(resourceName, "method_setting.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing a setting from the configuration resets it.
+				Config: testAccAWSAPIGatewayStageMethodSettingsConfig(rInt, `
+  method_setting {
+    method_path     = "test/GET"
+    metrics_enabled = true
+    logging_level   = "INFO"
+  }
+
+  method_setting {
+    method_path = "test/POST"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayMethodSettingsExists(resourceName, &stage),
+					func(s *terraform.State) error {
+						setting, ok := stage.MethodSettings["test/POST"]
+						if !ok {
+							return fmt.Errorf("method settings for test/POST not found")
+						}
+						if v := aws.Int64Value(setting.ThrottlingBurstLimit); v != -1 {
+							return fmt.Errorf("expected throttling burst limit of test/POST to be reset to -1, got %d", v)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayStageMethodSettingsConfig(rInt, `
+  method_setting {
+    method_path     = "test/GET"
+    metrics_enabled = false
+    logging_level   = "OFF"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayMethodSettingsExists(resourceName, &stage),
+					testAccCheckAWSAPIGatewayMethodSettings_metricsEnabled(&stage, "test/GET", false),
+					testAccCheckAWSAPIGatewayMethodSettings_loggingLevel(&stage, "test/GET", "OFF"),
+					testAccCheckAWSAPIGatewayStageMethodSettingsPaths(&stage, "test/GET"),
+					resource.TestCheckResourceAttr(resourceName, "method_setting.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandApiGatewayStageMethodSettingsPatchOperations(t *testing.T) {
+	setting := func(path string, metrics bool, level string) map[string]interface{} {
+		m := map[string]interface{}{"method_path": path}
+		for _, p := range apiGatewayStageMethodSettingPatchPaths {
+			m[p.attribute] = p.defaultValue
+		}
+		m["metrics_enabled"] = metrics
+		m["logging_level"] = level
+		return m
+	}
+
+	throttled := setting("d/GET", false, "OFF")
+	throttled["throttling_burst_limit"] = 10
+
+	old := map[string]map[string]interface{}{
+		"a/GET": setting("a/GET", true, "INFO"),
+		"b/GET": setting("b/GET", true, "INFO"),
+		"d/GET": throttled,
+	}
+	new := map[string]map[string]interface{}{
+		"a/GET": setting("a/GET", true, "ERROR"),
+		"c/GET": setting("c/GET", false, "OFF"),
+		"d/GET": setting("d/GET", false, "OFF"),
+	}
+
+	expected := []*apigateway.PatchOperation{
+		{Op: aws.String("remove"), Path: aws.String("/b/GET")},
+		{Op: aws.String("replace"), Path: aws.String("/a/GET/logging/loglevel"), Value: aws.String("ERROR")},
+		{Op: aws.String("replace"), Path: aws.String("/c/GET/metrics/enabled"), Value: aws.String("false")},
+		{Op: aws.String("replace"), Path: aws.String("/d/GET/throttling/burstLimit"), Value: aws.String("-1")},
+	}
+
+	ops := expandApiGatewayStageMethodSettingsPatchOperations(old, new)
+	if !reflect.DeepEqual(ops, expected) {
+		t.Fatalf("Expected patch operations:\n%s\ngot:\n%s", expected, ops)
+	}
+}
+
+func testAccCheckAWSAPIGatewayStageMethodSettingsPaths(conf *apigateway.Stage, paths ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(conf.MethodSettings) != len(paths) {
+			return fmt.Errorf("Expected %d method settings, got %d", len(paths), len(conf.MethodSettings))
+		}
+
+		for _, path := range paths {
+			if _, ok := conf.MethodSettings[path]; !ok {
+				return fmt.Errorf("Expected to find method settings for %q", path)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAPIGatewayStageMethodSettingsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigateway
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_api_gateway_stage_method_settings" {
+			continue
+		}
+
+		out, err := conn.GetStage(&apigateway.GetStageInput{
+			StageName: aws.String(rs.Primary.Attributes["stage_name"]),
+			RestApiId: aws.String(rs.Primary.Attributes["rest_api_id"]),
+		})
+		if isAWSErr(err, apigateway.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(out.MethodSettings) > 0 {
+			return fmt.Errorf("API Gateway Stage (%s) still has method settings", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSAPIGatewayStageMethodSettingsConfig(rInt int, methodSettings string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = "tf-acc-test-apig-stage-method-%d"
+}
+
+resource "aws_api_gateway_resource" "test" {
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  parent_id   = "${aws_api_gateway_rest_api.test.root_resource_id}"
+  path_part   = "test"
+}
+
+resource "aws_api_gateway_method" "get" {
+  rest_api_id   = "${aws_api_gateway_rest_api.test.id}"
+  resource_id   = "${aws_api_gateway_resource.test.id}"
+  http_method   = "GET"
+  authorization = "NONE"
+}
+
+resource "aws_api_gateway_method" "post" {
+  rest_api_id   = "${aws_api_gateway_rest_api.test.id}"
+  resource_id   = "${aws_api_gateway_resource.test.id}"
+  http_method   = "POST"
+  authorization = "NONE"
+}
+
+resource "aws_api_gateway_integration" "get" {
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  resource_id = "${aws_api_gateway_resource.test.id}"
+  http_method = "${aws_api_gateway_method.get.http_method}"
+  type        = "MOCK"
+}
+
+resource "aws_api_gateway_integration" "post" {
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  resource_id = "${aws_api_gateway_resource.test.id}"
+  http_method = "${aws_api_gateway_method.post.http_method}"
+  type        = "MOCK"
+}
+
+resource "aws_api_gateway_deployment" "test" {
+  depends_on  = ["aws_api_gateway_integration.get", "aws_api_gateway_integration.post"]
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  stage_name  = "dev"
+}
+
+resource "aws_api_gateway_stage_method_settings" "test" {
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  stage_name  = "${aws_api_gateway_deployment.test.stage_name}"
+%s
+}
+`, rInt, methodSettings)
+}
@@ -28,17 +28,7 @@ func TestAccAWSCloudTrail(t *testing.T) {
 		},
 	}
 
-	for group, m := range testCases {
-		m := m
-		t.Run(group, func(t *testing.T) {
-			for name, tc := range m {
-				tc := tc
-				t.Run(name, func(t *testing.T) {
-					tc(t)
-				})
-			}
-		})
-	}
+	testAccRunSerialTests(t, testCases, 0)
 }
 
 func TestAccAWSCloudTrail_importBasic(t *testing.T) {
@@ -0,0 +1,414 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// DescribeAlarms and DeleteAlarms accept at most 100 alarm names per call.
+const cloudWatchMetricAlarmsBatchSize = 100
+
+func resourceAwsCloudWatchMetricAlarms() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchMetricAlarmsCreate,
+		Read:   resourceAwsCloudWatchMetricAlarmsRead,
+		Update: resourceAwsCloudWatchMetricAlarmsUpdate,
+		Delete: resourceAwsCloudWatchMetricAlarmsDelete,
+
+		CustomizeDiff: resourceAwsCloudWatchMetricAlarmsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"alarm": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"comparison_operator": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"evaluation_periods": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"metric_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"period": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"statistic": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"actions_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"alarm_actions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validateAny(
+									validateArn,
+									validateEC2AutomateARN,
+								),
+							},
+							Set: schema.HashString,
+						},
+						"alarm_description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"datapoints_to_alarm": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"dimensions": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"insufficient_data_actions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"ok_actions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"extended_statistic": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"treat_missing_data": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "missing",
+							ValidateFunc: validation.StringInSlice([]string{"breaching", "notBreaching", "ignore", "missing"}, true),
+						},
+						"evaluate_low_sample_count_percentiles": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"evaluate", "ignore"}, true),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchMetricAlarmsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("alarm") {
+		return nil
+	}
+
+	alarms := diff.Get("alarm").(*schema.Set).List()
+	if err := validateCloudWatchMetricAlarmsUniqueNames(alarms); err != nil {
+		return err
+	}
+
+	return validateCloudWatchMetricAlarmsStatistics(alarms)
+}
+
+func validateCloudWatchMetricAlarmsUniqueNames(alarms []interface{}) error {
+	names := make(map[string]bool)
+	for _, v := range alarms {
+		name := v.(map[string]interface{})["alarm_name"].(string)
+		if names[name] {
+			return fmt.Errorf("CloudWatch Metric Alarm %q is configured more than once", name)
+		}
+		names[name] = true
+	}
+
+	return nil
+}
+
+func validateCloudWatchMetricAlarmsStatistics(alarms []interface{}) error {
+	for _, v := range alarms {
+		m := v.(map[string]interface{})
+		name := m["alarm_name"].(string)
+		statistic := m["statistic"].(string)
+		extendedStatistic := m["extended_statistic"].(string)
+
+		if statistic == "" && extendedStatistic == "" {
+			return fmt.Errorf("One of `statistic` or `extended_statistic` must be set for CloudWatch Metric Alarm %q", name)
+		}
+		if statistic != "" && extendedStatistic != "" {
+			return fmt.Errorf("Only one of `statistic` or `extended_statistic` can be set for CloudWatch Metric Alarm %q", name)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchMetricAlarmsCreate(d *schema.ResourceData, meta interface{}) error {
+	// The ID is set first so alarms put before a failure are kept in state.
+	d.SetId(resource.UniqueId())
+
+	if err := resourceAwsCloudWatchMetricAlarmsReconcile(d, meta); err != nil {
+		return err
+	}
+
+	return resourceAwsCloudWatchMetricAlarmsRead(d, meta)
+}
+
+func resourceAwsCloudWatchMetricAlarmsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	names := make([]string, 0)
+	for _, v := range d.Get("alarm").(*schema.Set).List() {
+		names = append(names, v.(map[string]interface{})["alarm_name"].(string))
+	}
+
+	alarms := make([]interface{}, 0, len(names))
+	for _, chunk := range chunkCloudWatchMetricAlarmNames(names) {
+		input := &cloudwatch.DescribeAlarmsInput{
+			AlarmNames: aws.StringSlice(chunk),
+			MaxRecords: aws.Int64(cloudWatchMetricAlarmsBatchSize),
+		}
+
+		log.Printf("[DEBUG] Reading CloudWatch Metric Alarms: %s", input)
+		err := conn.DescribeAlarmsPages(input, func(page *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+			for _, a := range page.MetricAlarms {
+				alarms = append(alarms, flattenCloudWatchMetricAlarm(a))
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return fmt.Errorf("Error reading CloudWatch Metric Alarms: %s", err)
+		}
+	}
+
+	if len(alarms) == 0 {
+		log.Printf("[WARN] No CloudWatch Metric Alarms of %s found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("alarm", alarms); err != nil {
+		return fmt.Errorf("error setting alarm: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchMetricAlarmsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceAwsCloudWatchMetricAlarmsReconcile(d, meta); err != nil {
+		return err
+	}
+
+	return resourceAwsCloudWatchMetricAlarmsRead(d, meta)
+}
+
+func resourceAwsCloudWatchMetricAlarmsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	names := make([]string, 0)
+	for _, v := range d.Get("alarm").(*schema.Set).List() {
+		names = append(names, v.(map[string]interface{})["alarm_name"].(string))
+	}
+
+	return deleteCloudWatchMetricAlarms(conn, names)
+}
+
+// resourceAwsCloudWatchMetricAlarmsReconcile deletes the alarms no longer
+// configured and puts the new and changed ones. On failure, the state keeps
+// every old and new alarm so the next refresh finds all alarms that exist.
+func resourceAwsCloudWatchMetricAlarmsReconcile(d *schema.ResourceData, meta interface{}) error {
+	o, n := d.GetChange("alarm")
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+
+	if err := reconcileCloudWatchMetricAlarms(meta.(*AWSClient).cloudwatchconn, os, ns); err != nil {
+		if err := d.Set("alarm", os.Union(ns)); err != nil {
+			log.Printf("[WARN] Error setting alarm: %s", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func reconcileCloudWatchMetricAlarms(conn *cloudwatch.CloudWatch, os, ns *schema.Set) error {
+	oldAlarms := make(map[string]int)
+	for _, v := range os.List() {
+		oldAlarms[v.(map[string]interface{})["alarm_name"].(string)] = os.F(v)
+	}
+
+	newAlarms := make(map[string]map[string]interface{})
+	for _, v := range ns.List() {
+		m := v.(map[string]interface{})
+		newAlarms[m["alarm_name"].(string)] = m
+	}
+
+	removed := make([]string, 0)
+	for name := range oldAlarms {
+		if _, ok := newAlarms[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+
+	if err := deleteCloudWatchMetricAlarms(conn, removed); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(newAlarms))
+	for name := range newAlarms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		m := newAlarms[name]
+		if hash, ok := oldAlarms[name]; ok && hash == ns.F(m) {
+			continue
+		}
+
+		input := expandCloudWatchPutMetricAlarmInput(m)
+		log.Printf("[DEBUG] Putting CloudWatch Metric Alarm: %s", input)
+		if _, err := conn.PutMetricAlarm(input); err != nil {
+			return fmt.Errorf("Error putting CloudWatch Metric Alarm %q: %s", name, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteCloudWatchMetricAlarms(conn *cloudwatch.CloudWatch, names []string) error {
+	for _, chunk := range chunkCloudWatchMetricAlarmNames(names) {
+		log.Printf("[INFO] Deleting CloudWatch Metric Alarms: %v", chunk)
+		_, err := conn.DeleteAlarms(&cloudwatch.DeleteAlarmsInput{
+			AlarmNames: aws.StringSlice(chunk),
+		})
+		if isAWSErr(err, cloudwatch.ErrCodeResourceNotFound, "") {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("Error deleting CloudWatch Metric Alarms: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func chunkCloudWatchMetricAlarmNames(names []string) [][]string {
+	chunks := make([][]string, 0)
+	for i := 0; i < len(names); i += cloudWatchMetricAlarmsBatchSize {
+		end := i + cloudWatchMetricAlarmsBatchSize
+		if end > len(names) {
+			end = len(names)
+		}
+		chunks = append(chunks, names[i:end])
+	}
+	return chunks
+}
+
+func expandCloudWatchPutMetricAlarmInput(m map[string]interface{}) *cloudwatch.PutMetricAlarmInput {
+	name := m["alarm_name"].(string)
+	statistic := m["statistic"].(string)
+	extendedStatistic := m["extended_statistic"].(string)
+
+	input := &cloudwatch.PutMetricAlarmInput{
+		ActionsEnabled:          aws.Bool(m["actions_enabled"].(bool)),
+		AlarmActions:            expandStringSet(m["alarm_actions"].(*schema.Set)),
+		AlarmName:               aws.String(name),
+		ComparisonOperator:      aws.String(m["comparison_operator"].(string)),
+		EvaluationPeriods:       aws.Int64(int64(m["evaluation_periods"].(int))),
+		InsufficientDataActions: expandStringSet(m["insufficient_data_actions"].(*schema.Set)),
+		MetricName:              aws.String(m["metric_name"].(string)),
+		Namespace:               aws.String(m["namespace"].(string)),
+		OKActions:               expandStringSet(m["ok_actions"].(*schema.Set)),
+		Period:                  aws.Int64(int64(m["period"].(int))),
+		Threshold:               aws.Float64(m["threshold"].(float64)),
+		TreatMissingData:        aws.String(m["treat_missing_data"].(string)),
+	}
+
+	if statistic != "" {
+		input.Statistic = aws.String(statistic)
+	}
+	if extendedStatistic != "" {
+		input.ExtendedStatistic = aws.String(extendedStatistic)
+	}
+	if v := m["alarm_description"].(string); v != "" {
+		input.AlarmDescription = aws.String(v)
+	}
+	if v := m["datapoints_to_alarm"].(int); v > 0 {
+		input.DatapointsToAlarm = aws.Int64(int64(v))
+	}
+	if v := m["unit"].(string); v != "" {
+		input.Unit = aws.String(v)
+	}
+	if v := m["evaluate_low_sample_count_percentiles"].(string); v != "" {
+		input.EvaluateLowSampleCountPercentile = aws.String(v)
+	}
+
+	dimensions := m["dimensions"].(map[string]interface{})
+	input.Dimensions = make([]*cloudwatch.Dimension, 0, len(dimensions))
+	for k, v := range dimensions {
+		input.Dimensions = append(input.Dimensions, &cloudwatch.Dimension{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return input
+}
+
+func flattenCloudWatchMetricAlarm(a *cloudwatch.MetricAlarm) map[string]interface{} {
+	return map[string]interface{}{
+		"alarm_name":                            aws.StringValue(a.AlarmName),
+		"comparison_operator":                   aws.StringValue(a.ComparisonOperator),
+		"evaluation_periods":                    int(aws.Int64Value(a.EvaluationPeriods)),
+		"metric_name":                           aws.StringValue(a.MetricName),
+		"namespace":                             aws.StringValue(a.Namespace),
+		"period":                                int(aws.Int64Value(a.Period)),
+		"statistic":                             aws.StringValue(a.Statistic),
+		"threshold":                             aws.Float64Value(a.Threshold),
+		"actions_enabled":                       aws.BoolValue(a.ActionsEnabled),
+		"alarm_actions":                         schema.NewSet(schema.HashString, flattenStringList(a.AlarmActions)),
+		"alarm_description":                     aws.StringValue(a.AlarmDescription),
+		"datapoints_to_alarm":                   int(aws.Int64Value(a.DatapointsToAlarm)),
+		"dimensions":                            flattenDimensions(a.Dimensions),
+		"insufficient_data_actions":             schema.NewSet(schema.HashString, flattenStringList(a.InsufficientDataActions)),
+		"ok_actions":                            schema.NewSet(schema.HashString, flattenStringList(a.OKActions)),
+		"unit":                                  aws.StringValue(a.Unit),
+		"extended_statistic":                    aws.StringValue(a.ExtendedStatistic),
+		"treat_missing_data":                    aws.StringValue(a.TreatMissingData),
+		"evaluate_low_sample_count_percentiles": aws.StringValue(a.EvaluateLowSampleCountPercentile),
+	}
+}
@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestChunkCloudWatchMetricAlarmNames(t *testing.T) {
+	names := make([]string, 0)
+	for i := 0; i < 250; i++ {
+		names = append(names, fmt.Sprintf("alarm-%d", i))
+	}
+
+	chunks := chunkCloudWatchMetricAlarmNames(names)
+	if len(chunks) != 3 {
+		t.Fatalf("Expected 3 chunks, got %d", len(chunks))
+	}
+	if len(chunks[0]) != 100 || len(chunks[1]) != 100 || len(chunks[2]) != 50 {
+		t.Fatalf("Unexpected chunk sizes: %d, %d, %d", len(chunks[0]), len(chunks[1]), len(chunks[2]))
+	}
+
+	if len(chunkCloudWatchMetricAlarmNames(nil)) != 0 {
+		t.Fatal("Expected no chunks for no alarm names")
+	}
+}
+
+func TestAccAWSCloudWatchMetricAlarms_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_metric_alarms.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchMetricAlarmsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchMetricAlarmsConfig(rName, 3, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchMetricAlarmsExist(rName, 3, 80),
+					resource.TestCheckResourceAttr(resourceName, "alarm.#", "3"),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchMetricAlarmsConfig(rName, 2, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchMetricAlarmsExist(rName, 2, 90),
+					resource.TestCheckResourceAttr(resourceName, "alarm.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchMetricAlarms_duplicateName(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchMetricAlarmsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudWatchMetricAlarmsConfigDuplicateName(rName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`is configured more than once`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchMetricAlarms_statisticConflict(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchMetricAlarmsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudWatchMetricAlarmsConfigStatisticConflict(rName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Only one of .statistic. or .extended_statistic. can be set`),
+			},
+		},
+	})
+}
+
+func TestValidateCloudWatchMetricAlarmsUniqueNames(t *testing.T) {
+	alarms := []interface{}{
+		map[string]interface{}{"alarm_name": "a"},
+		map[string]interface{}{"alarm_name": "b"},
+	}
+	if err := validateCloudWatchMetricAlarmsUniqueNames(alarms); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	alarms = append(alarms, map[string]interface{}{"alarm_name": "a"})
+	if err := validateCloudWatchMetricAlarmsUniqueNames(alarms); err == nil {
+		t.Fatal("expected error for duplicate alarm_name")
+	}
+}
+
+func TestValidateCloudWatchMetricAlarmsStatistics(t *testing.T) {
+	alarm := func(statistic, extendedStatistic string) map[string]interface{} {
+		return map[string]interface{}{
+			"alarm_name":         "a",
+			"statistic":          statistic,
+			"extended_statistic": extendedStatistic,
+		}
+	}
+
+	cases := []struct {
+		Alarm    map[string]interface{}
+		ErrCount int
+	}{
+		{alarm("Average", ""), 0},
+		{alarm("", "p99"), 0},
+		{alarm("", ""), 1},
+		{alarm("Average", "p99"), 1},
+	}
+
+	for _, tc := range cases {
+		err := validateCloudWatchMetricAlarmsStatistics([]interface{}{tc.Alarm})
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("unexpected error for %#v: %s", tc.Alarm, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected error for %#v", tc.Alarm)
+		}
+	}
+}
+
+func testAccCheckAWSCloudWatchMetricAlarmsExist(rName string, count int, threshold float64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+		resp, err := conn.DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+			AlarmNamePrefix: aws.String(rName),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.MetricAlarms) != count {
+			return fmt.Errorf("Expected %d CloudWatch Metric Alarms, got %d", count, len(resp.MetricAlarms))
+		}
+		for _, a := range resp.MetricAlarms {
+			if aws.Float64Value(a.Threshold) != threshold {
+				return fmt.Errorf("Expected CloudWatch Metric Alarm %q threshold %f, got %f", aws.StringValue(a.AlarmName), threshold, aws.Float64Value(a.Threshold))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudWatchMetricAlarmsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_metric_alarms" {
+			continue
+		}
+
+		names := make([]*string, 0)
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasSuffix(k, ".alarm_name") {
+				names = append(names, aws.String(v))
+			}
+		}
+
+		resp, err := conn.DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+			AlarmNames: names,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.MetricAlarms) > 0 {
+			return fmt.Errorf("CloudWatch Metric Alarms still exist: %d", len(resp.MetricAlarms))
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCloudWatchMetricAlarmsConfig(rName string, count int, threshold int) string {
+	alarms := ""
+	for i := 0; i < count; i++ {
+		alarms += fmt.Sprintf(`
+  alarm {
+    alarm_name          = "%s-%d"
+    comparison_operator = "GreaterThanOrEqualToThreshold"
+    evaluation_periods  = 2
+    metric_name         = "CPUUtilization"
+    namespace           = "AWS/EC2"
+    period              = 120
+    statistic           = "Average"
+    threshold           = %d
+
+    dimensions {
+      InstanceId = "i-abc12%d"
+    }
+  }
+`, rName, i, threshold, i)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarms" "test" {
+%s
+}
+`, alarms)
+}
+
+func testAccAWSCloudWatchMetricAlarmsConfigDuplicateName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarms" "test" {
+  alarm {
+    alarm_name          = "%[1]s"
+    comparison_operator = "GreaterThanOrEqualToThreshold"
+    evaluation_periods  = 2
+    metric_name         = "CPUUtilization"
+    namespace           = "AWS/EC2"
+    period              = 120
+    statistic           = "Average"
+    threshold           = 80
+  }
+
+  alarm {
+    alarm_name          = "%[1]s"
+    comparison_operator = "GreaterThanOrEqualToThreshold"
+    evaluation_periods  = 2
+    metric_name         = "CPUUtilization"
+    namespace           = "AWS/EC2"
+    period              = 120
+    statistic           = "Average"
+    threshold           = 90
+  }
+}
+`, rName)
+}
+
+func testAccAWSCloudWatchMetricAlarmsConfigStatisticConflict(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarms" "test" {
+  alarm {
+    alarm_name          = %q
+    comparison_operator = "GreaterThanOrEqualToThreshold"
+    evaluation_periods  = 2
+    metric_name         = "CPUUtilization"
+    namespace           = "AWS/EC2"
+    period              = 120
+    statistic           = "Average"
+    extended_statistic  = "p99"
+    threshold           = 80
+  }
+}
+`, rName)
+}
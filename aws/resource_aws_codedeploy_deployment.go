@@ -0,0 +1,603 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCodeDeployDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCodeDeployDeploymentCreate,
+		Read:   resourceAwsCodeDeployDeploymentRead,
+		Update: resourceAwsCodeDeployDeploymentUpdate,
+		Delete: resourceAwsCodeDeployDeploymentDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"deployment_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"deployment_config_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"file_exists_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					codedeploy.FileExistsBehaviorDisallow,
+					codedeploy.FileExistsBehaviorOverwrite,
+					codedeploy.FileExistsBehaviorRetain,
+				}, false),
+			},
+
+			"ignore_application_stop_failures": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"update_outdated_instances_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"revision": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"revision_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								codedeploy.RevisionLocationTypeGitHub,
+								codedeploy.RevisionLocationTypeS3,
+								codedeploy.RevisionLocationTypeString,
+							}, false),
+						},
+
+						"app_spec_content": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"content": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"sha256": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+
+						"github_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"commit_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"repository": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+
+						"s3_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"bundle_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											codedeploy.BundleTypeJson,
+											codedeploy.BundleTypeTar,
+											codedeploy.BundleTypeTgz,
+											codedeploy.BundleTypeYaml,
+											codedeploy.BundleTypeZip,
+										}, false),
+									},
+									"etag": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"wait_for_deployment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"complete_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"deployment_overview": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+
+			"rollback_info": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rollback_deployment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rollback_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rollback_triggering_deployment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCodeDeployDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codedeployconn
+
+	input := &codedeploy.CreateDeploymentInput{
+		ApplicationName:               aws.String(d.Get("app_name").(string)),
+		DeploymentGroupName:           aws.String(d.Get("deployment_group_name").(string)),
+		IgnoreApplicationStopFailures: aws.Bool(d.Get("ignore_application_stop_failures").(bool)),
+		Revision:                      expandCodeDeployRevisionLocation(d.Get("revision").([]interface{})),
+		UpdateOutdatedInstancesOnly:   aws.Bool(d.Get("update_outdated_instances_only").(bool)),
+	}
+
+	if v, ok := d.GetOk("deployment_config_name"); ok {
+		input.DeploymentConfigName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("file_exists_behavior"); ok {
+		input.FileExistsBehavior = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating CodeDeploy Deployment: %s", input)
+	output, err := conn.CreateDeployment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CodeDeploy Deployment: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.DeploymentId))
+
+	if d.Get("wait_for_deployment").(bool) {
+		log.Printf("[DEBUG] Waiting for CodeDeploy Deployment (%s) to complete", d.Id())
+		if err := waitForCodeDeployDeploymentCompletion(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for CodeDeploy Deployment (%s) to complete: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsCodeDeployDeploymentRead(d, meta)
+}
+
+func resourceAwsCodeDeployDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codedeployconn
+
+	deployment, err := getCodeDeployDeployment(conn, d.Id())
+
+	if isAWSErr(err, codedeploy.ErrCodeDeploymentDoesNotExistException, "") {
+		log.Printf("[WARN] CodeDeploy Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CodeDeploy Deployment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("app_name", deployment.ApplicationName)
+	d.Set("deployment_config_name", deployment.DeploymentConfigName)
+	d.Set("deployment_group_name", deployment.DeploymentGroupName)
+	d.Set("deployment_id", deployment.DeploymentId)
+	d.Set("description", deployment.Description)
+	d.Set("file_exists_behavior", deployment.FileExistsBehavior)
+	d.Set("ignore_application_stop_failures", deployment.IgnoreApplicationStopFailures)
+	d.Set("status", deployment.Status)
+	d.Set("update_outdated_instances_only", deployment.UpdateOutdatedInstancesOnly)
+
+	if deployment.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(deployment.CreateTime).Format(time.RFC3339))
+	}
+
+	if deployment.CompleteTime != nil {
+		d.Set("complete_time", aws.TimeValue(deployment.CompleteTime).Format(time.RFC3339))
+	}
+
+	if err := d.Set("deployment_overview", flattenCodeDeployDeploymentOverview(deployment.DeploymentOverview)); err != nil {
+		return fmt.Errorf("error setting deployment_overview: %s", err)
+	}
+
+	if err := d.Set("rollback_info", flattenCodeDeployRollbackInfo(deployment.RollbackInfo)); err != nil {
+		return fmt.Errorf("error setting rollback_info: %s", err)
+	}
+
+	return nil
+}
+
+// resourceAwsCodeDeployDeploymentUpdate only handles wait_for_deployment,
+// every other argument creates a new deployment.
+func resourceAwsCodeDeployDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceAwsCodeDeployDeploymentRead(d, meta)
+}
+
+func resourceAwsCodeDeployDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codedeployconn
+
+	deployment, err := getCodeDeployDeployment(conn, d.Id())
+
+	if isAWSErr(err, codedeploy.ErrCodeDeploymentDoesNotExistException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CodeDeploy Deployment (%s): %s", d.Id(), err)
+	}
+
+	// Completed deployments cannot be deleted, they are only removed from state.
+	switch aws.StringValue(deployment.Status) {
+	case codedeploy.DeploymentStatusCreated, codedeploy.DeploymentStatusQueued, codedeploy.DeploymentStatusInProgress, codedeploy.DeploymentStatusReady:
+		log.Printf("[DEBUG] Stopping CodeDeploy Deployment: %s", d.Id())
+		_, err := conn.StopDeployment(&codedeploy.StopDeploymentInput{
+			DeploymentId: aws.String(d.Id()),
+		})
+
+		if isAWSErr(err, codedeploy.ErrCodeDeploymentAlreadyCompletedException, "") {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error stopping CodeDeploy Deployment (%s): %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func getCodeDeployDeployment(conn *codedeploy.CodeDeploy, deploymentID string) (*codedeploy.DeploymentInfo, error) {
+	output, err := conn.GetDeployment(&codedeploy.GetDeploymentInput{
+		DeploymentId: aws.String(deploymentID),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DeploymentInfo == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	return output.DeploymentInfo, nil
+}
+
+func codeDeployDeploymentRefreshFunc(conn *codedeploy.CodeDeploy, deploymentID string) resource.StateRefreshFunc {
+	instanceProgress := make(map[string]string)
+
+	return func() (interface{}, string, error) {
+		deployment, err := getCodeDeployDeployment(conn, deploymentID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(deployment.Status)
+		log.Printf("[INFO] CodeDeploy Deployment (%s) status: %s (%s)", deploymentID, status, codeDeployDeploymentOverviewString(deployment.DeploymentOverview))
+		logCodeDeployDeploymentInstanceProgress(conn, deploymentID, instanceProgress)
+
+		switch status {
+		case codedeploy.DeploymentStatusFailed, codedeploy.DeploymentStatusStopped:
+			return deployment, status, fmt.Errorf("deployment %s: %s", strings.ToLower(status), codeDeployDeploymentFailureReason(deployment))
+		}
+
+		return deployment, status, nil
+	}
+}
+
+// waitForCodeDeployDeploymentCompletion waits until the deployment succeeded
+// or, for blue/green deployments with manual traffic rerouting, is ready.
+func waitForCodeDeployDeploymentCompletion(conn *codedeploy.CodeDeploy, deploymentID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			codedeploy.DeploymentStatusCreated,
+			codedeploy.DeploymentStatusInProgress,
+			codedeploy.DeploymentStatusQueued,
+		},
+		Target: []string{
+			codedeploy.DeploymentStatusReady,
+			codedeploy.DeploymentStatusSucceeded,
+		},
+		Refresh:    codeDeployDeploymentRefreshFunc(conn, deploymentID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func codeDeployDeploymentFailureReason(deployment *codedeploy.DeploymentInfo) string {
+	var reasons []string
+
+	if v := deployment.ErrorInformation; v != nil {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message)))
+	}
+
+	if v := deployment.RollbackInfo; v != nil {
+		if id := aws.StringValue(v.RollbackDeploymentId); id != "" {
+			reasons = append(reasons, fmt.Sprintf("rolled back by deployment %s: %s", id, aws.StringValue(v.RollbackMessage)))
+		} else if message := aws.StringValue(v.RollbackMessage); message != "" {
+			reasons = append(reasons, fmt.Sprintf("rollback: %s", message))
+		}
+	}
+
+	if len(reasons) == 0 {
+		return "unknown reason"
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+// logCodeDeployDeploymentInstanceProgress logs the lifecycle event status of
+// each instance of the deployment whenever it changed since the last call.
+// Failures are only logged, as instance details are informational.
+func logCodeDeployDeploymentInstanceProgress(conn *codedeploy.CodeDeploy, deploymentID string, progress map[string]string) {
+	var instanceIDs []*string
+	err := conn.ListDeploymentInstancesPages(&codedeploy.ListDeploymentInstancesInput{
+		DeploymentId: aws.String(deploymentID),
+	}, func(page *codedeploy.ListDeploymentInstancesOutput, lastPage bool) bool {
+		instanceIDs = append(instanceIDs, page.InstancesList...)
+		return !lastPage
+	})
+	if err != nil {
+		log.Printf("[DEBUG] Error listing CodeDeploy Deployment (%s) instances: %s", deploymentID, err)
+		return
+	}
+
+	// BatchGetDeploymentInstances accepts at most 25 instance IDs per call.
+	for i := 0; i < len(instanceIDs); i += 25 {
+		end := i + 25
+		if end > len(instanceIDs) {
+			end = len(instanceIDs)
+		}
+
+		output, err := conn.BatchGetDeploymentInstances(&codedeploy.BatchGetDeploymentInstancesInput{
+			DeploymentId: aws.String(deploymentID),
+			InstanceIds:  instanceIDs[i:end],
+		})
+		if err != nil {
+			log.Printf("[DEBUG] Error getting CodeDeploy Deployment (%s) instances: %s", deploymentID, err)
+			return
+		}
+
+		for _, instance := range output.InstancesSummary {
+			id := aws.StringValue(instance.InstanceId)
+			summary := fmt.Sprintf("%s (%s)", aws.StringValue(instance.Status), codeDeployLifecycleEventsString(instance.LifecycleEvents))
+			if progress[id] == summary {
+				continue
+			}
+			progress[id] = summary
+			log.Printf("[INFO] CodeDeploy Deployment (%s) instance %s: %s", deploymentID, id, summary)
+		}
+	}
+}
+
+func codeDeployLifecycleEventsString(events []*codedeploy.LifecycleEvent) string {
+	if len(events) == 0 {
+		return "no lifecycle events"
+	}
+
+	parts := make([]string, 0, len(events))
+	for _, event := range events {
+		part := fmt.Sprintf("%s: %s", aws.StringValue(event.LifecycleEventName), aws.StringValue(event.Status))
+		if event.Diagnostics != nil && aws.StringValue(event.Status) == codedeploy.LifecycleEventStatusFailed {
+			part += fmt.Sprintf(" [%s: %s]", aws.StringValue(event.Diagnostics.ErrorCode), aws.StringValue(event.Diagnostics.Message))
+		}
+		parts = append(parts, part)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func codeDeployDeploymentOverviewString(overview *codedeploy.DeploymentOverview) string {
+	if overview == nil {
+		return "no overview"
+	}
+
+	return fmt.Sprintf("pending: %d, in progress: %d, succeeded: %d, failed: %d, skipped: %d, ready: %d",
+		aws.Int64Value(overview.Pending),
+		aws.Int64Value(overview.InProgress),
+		aws.Int64Value(overview.Succeeded),
+		aws.Int64Value(overview.Failed),
+		aws.Int64Value(overview.Skipped),
+		aws.Int64Value(overview.Ready))
+}
+
+func expandCodeDeployRevisionLocation(l []interface{}) *codedeploy.RevisionLocation {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	revision := &codedeploy.RevisionLocation{
+		RevisionType: aws.String(m["revision_type"].(string)),
+	}
+
+	if v, ok := m["app_spec_content"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		content := v[0].(map[string]interface{})
+
+		revision.String_ = &codedeploy.RawString{
+			Content: aws.String(content["content"].(string)),
+		}
+
+		if v, ok := content["sha256"].(string); ok && v != "" {
+			revision.String_.Sha256 = aws.String(v)
+		}
+	}
+
+	if v, ok := m["github_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		location := v[0].(map[string]interface{})
+
+		revision.GitHubLocation = &codedeploy.GitHubLocation{
+			CommitId:   aws.String(location["commit_id"].(string)),
+			Repository: aws.String(location["repository"].(string)),
+		}
+	}
+
+	if v, ok := m["s3_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		location := v[0].(map[string]interface{})
+
+		revision.S3Location = &codedeploy.S3Location{
+			Bucket:     aws.String(location["bucket"].(string)),
+			BundleType: aws.String(location["bundle_type"].(string)),
+			Key:        aws.String(location["key"].(string)),
+		}
+
+		if v, ok := location["etag"].(string); ok && v != "" {
+			revision.S3Location.ETag = aws.String(v)
+		}
+
+		if v, ok := location["version"].(string); ok && v != "" {
+			revision.S3Location.Version = aws.String(v)
+		}
+	}
+
+	return revision
+}
+
+func flattenCodeDeployDeploymentOverview(overview *codedeploy.DeploymentOverview) map[string]interface{} {
+	if overview == nil {
+		return map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"failed":      int(aws.Int64Value(overview.Failed)),
+		"in_progress": int(aws.Int64Value(overview.InProgress)),
+		"pending":     int(aws.Int64Value(overview.Pending)),
+		"ready":       int(aws.Int64Value(overview.Ready)),
+		"skipped":     int(aws.Int64Value(overview.Skipped)),
+		"succeeded":   int(aws.Int64Value(overview.Succeeded)),
+	}
+}
+
+func flattenCodeDeployRollbackInfo(rollbackInfo *codedeploy.RollbackInfo) []interface{} {
+	if rollbackInfo == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"rollback_deployment_id":            aws.StringValue(rollbackInfo.RollbackDeploymentId),
+		"rollback_message":                  aws.StringValue(rollbackInfo.RollbackMessage),
+		"rollback_triggering_deployment_id": aws.StringValue(rollbackInfo.RollbackTriggeringDeploymentId),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,343 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestCodeDeployLifecycleEventsString(t *testing.T) {
+	cases := []struct {
+		Events   []*codedeploy.LifecycleEvent
+		Expected string
+	}{
+		{nil, "no lifecycle events"},
+		{
+			[]*codedeploy.LifecycleEvent{
+				{LifecycleEventName: aws.String("BeforeInstall"), Status: aws.String(codedeploy.LifecycleEventStatusSucceeded)},
+				{
+					LifecycleEventName: aws.String("ApplicationStart"),
+					Status:             aws.String(codedeploy.LifecycleEventStatusFailed),
+					Diagnostics: &codedeploy.Diagnostics{
+						ErrorCode: aws.String(codedeploy.LifecycleErrorCodeScriptFailed),
+						Message:   aws.String("Script at specified location: start.sh run as user root failed with exit code 1"),
+					},
+				},
+				{LifecycleEventName: aws.String("ValidateService"), Status: aws.String(codedeploy.LifecycleEventStatusPending)},
+			},
+			"BeforeInstall: Succeeded, ApplicationStart: Failed [ScriptFailed: Script at specified location: start.sh run as user root failed with exit code 1], ValidateService: Pending",
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := codeDeployLifecycleEventsString(tc.Events); actual != tc.Expected {
+			t.Fatalf("expected %q, got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func TestAccAWSCodeDeployDeployment_basic(t *testing.T) {
+	var deployment codedeploy.DeploymentInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_codedeploy_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeDeployDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeDeployDeploymentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeDeployDeploymentExists(resourceName, &deployment),
+					resource.TestCheckResourceAttrPair(resourceName, "app_name", "aws_codedeploy_app.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_group_name", "aws_codedeploy_deployment_group.test", "deployment_group_name"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_config_name", "CodeDeployDefault.OneAtATime"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test deployment"),
+					resource.TestCheckResourceAttr(resourceName, "revision.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "revision.0.revision_type", "S3"),
+					resource.TestCheckResourceAttr(resourceName, "revision.0.s3_location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "revision.0.s3_location.0.bundle_type", "zip"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_deployment", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "deployment_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+				),
+			},
+		},
+	})
+}
+
+// Waiting for a deployment needs a deployment target that can succeed without
+// instances running the CodeDeploy agent, so a Lambda alias is shifted from
+// the first to the second version of a function.
+func TestAccAWSCodeDeployDeployment_waitForDeployment(t *testing.T) {
+	var deployment codedeploy.DeploymentInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_codedeploy_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeDeployDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeDeployDeploymentConfigLambdaBase(rName, "test-fixtures/lambdatest.zip"),
+			},
+			{
+				Config: testAccAWSCodeDeployDeploymentConfigWaitForDeployment(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeDeployDeploymentExists(resourceName, &deployment),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_deployment", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", codedeploy.DeploymentStatusSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCodeDeployDeploymentExists(name string, deployment *codedeploy.DeploymentInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CodeDeploy Deployment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).codedeployconn
+
+		output, err := getCodeDeployDeployment(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*deployment = *output
+
+		return nil
+	}
+}
+
+// Deployments cannot be deleted, so only ensure they are no longer running.
+func testAccCheckAWSCodeDeployDeploymentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).codedeployconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_codedeploy_deployment" {
+			continue
+		}
+
+		deployment, err := getCodeDeployDeployment(conn, rs.Primary.ID)
+
+		if isAWSErr(err, codedeploy.ErrCodeDeploymentDoesNotExistException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		switch status := aws.StringValue(deployment.Status); status {
+		case codedeploy.DeploymentStatusCreated, codedeploy.DeploymentStatusQueued, codedeploy.DeploymentStatusInProgress, codedeploy.DeploymentStatusReady:
+			return fmt.Errorf("CodeDeploy Deployment (%s) still running: %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCodeDeployDeploymentConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = "${aws_s3_bucket.test.bucket}"
+  key     = "revision.zip"
+  content = "test"
+}
+
+resource "aws_codedeploy_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "codedeploy.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = "${aws_iam_role.test.name}"
+  policy_arn = "arn:aws:iam::aws:policy/service-role/AWSCodeDeployRole"
+}
+
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name              = "${aws_codedeploy_app.test.name}"
+  deployment_group_name = %[1]q
+  service_role_arn      = "${aws_iam_role.test.arn}"
+
+  ec2_tag_filter {
+    key   = "Name"
+    type  = "KEY_AND_VALUE"
+    value = %[1]q
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+
+resource "aws_codedeploy_deployment" "test" {
+  app_name               = "${aws_codedeploy_app.test.name}"
+  deployment_group_name  = "${aws_codedeploy_deployment_group.test.deployment_group_name}"
+  deployment_config_name = "CodeDeployDefault.OneAtATime"
+  description            = "test deployment"
+  wait_for_deployment    = false
+
+  revision {
+    revision_type = "S3"
+
+    s3_location {
+      bucket      = "${aws_s3_bucket_object.test.bucket}"
+      key         = "${aws_s3_bucket_object.test.key}"
+      bundle_type = "zip"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSCodeDeployDeploymentConfigLambdaBase(rName, filename string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = %[2]q
+  function_name = %[1]q
+  role          = "${aws_iam_role.lambda.arn}"
+  handler       = "exports.example"
+  runtime       = "nodejs8.10"
+  publish       = true
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = "live"
+  function_name    = "${aws_lambda_function.test.function_name}"
+  function_version = "1"
+
+  # The deployment shifts the alias to the new version.
+  lifecycle {
+    ignore_changes = ["function_version"]
+  }
+}
+
+resource "aws_codedeploy_app" "test" {
+  name             = %[1]q
+  compute_platform = "Lambda"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "codedeploy.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = "${aws_iam_role.test.name}"
+  policy_arn = "arn:aws:iam::aws:policy/service-role/AWSCodeDeployRoleForLambda"
+}
+
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name               = "${aws_codedeploy_app.test.name}"
+  deployment_group_name  = %[1]q
+  deployment_config_name = "CodeDeployDefault.LambdaAllAtOnce"
+  service_role_arn       = "${aws_iam_role.test.arn}"
+
+  deployment_style {
+    deployment_option = "WITH_TRAFFIC_CONTROL"
+    deployment_type   = "BLUE_GREEN"
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName, filename)
+}
+
+func testAccAWSCodeDeployDeploymentConfigWaitForDeployment(rName string) string {
+	return testAccAWSCodeDeployDeploymentConfigLambdaBase(rName, "test-fixtures/lambdatest_modified.zip") + `
+resource "aws_codedeploy_deployment" "test" {
+  app_name              = "${aws_codedeploy_app.test.name}"
+  deployment_group_name = "${aws_codedeploy_deployment_group.test.deployment_group_name}"
+
+  revision {
+    revision_type = "String"
+
+    app_spec_content {
+      content = <<EOF
+version: 0.0
+Resources:
+  - test:
+      Type: AWS::Lambda::Function
+      Properties:
+        Name: "${aws_lambda_function.test.function_name}"
+        Alias: "${aws_lambda_alias.test.name}"
+        CurrentVersion: "1"
+        TargetVersion: "${aws_lambda_function.test.version}"
+EOF
+    }
+  }
+}
+`
+}
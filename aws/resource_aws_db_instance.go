@@ -25,6 +25,8 @@ func resourceAwsDbInstance() *schema.Resource {
 			State: resourceAwsDbInstanceImport,
 		},
 
+		CustomizeDiff: resourceAwsDbInstanceCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(40 * time.Minute),
 			Update: schema.DefaultTimeout(80 * time.Minute),
@@ -1316,6 +1318,37 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetPartial("apply_immediately")
 
+	// Promote before any other modification, since some settings (e.g.
+	// backups on PostgreSQL) cannot be changed while the instance is a replica.
+	if d.HasChange("replicate_source_db") {
+		if d.Get("replicate_source_db").(string) == "" {
+			opts := rds.PromoteReadReplicaInput{
+				DBInstanceIdentifier: aws.String(d.Id()),
+			}
+			attr := d.Get("backup_retention_period")
+			opts.BackupRetentionPeriod = aws.Int64(int64(attr.(int)))
+			if attr, ok := d.GetOk("backup_window"); ok {
+				opts.PreferredBackupWindow = aws.String(attr.(string))
+			}
+
+			log.Printf("[DEBUG] Promoting DB Instance read replica: %s", opts)
+			_, err := conn.PromoteReadReplica(&opts)
+			if err != nil {
+				return fmt.Errorf("error promoting DB Instance (%s) read replica: %s", d.Id(), err)
+			}
+
+			log.Printf("[DEBUG] Waiting for DB Instance (%s) to be available after promotion", d.Id())
+			err = waitUntilAwsDbInstanceIsAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return fmt.Errorf("error waiting for DB Instance (%s) to be available after promotion: %s", d.Id(), err)
+			}
+
+			d.SetPartial("replicate_source_db")
+		} else {
+			return fmt.Errorf("cannot elect new source database for replication")
+		}
+	}
+
 	if !aws.BoolValue(req.ApplyImmediately) {
 		log.Println("[INFO] Only settings updating, instance changes will be applied in next maintenance window")
 	}
@@ -1481,28 +1514,6 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	// separate request to promote a database
-	if d.HasChange("replicate_source_db") {
-		if d.Get("replicate_source_db").(string) == "" {
-			// promote
-			opts := rds.PromoteReadReplicaInput{
-				DBInstanceIdentifier: aws.String(d.Id()),
-			}
-			attr := d.Get("backup_retention_period")
-			opts.BackupRetentionPeriod = aws.Int64(int64(attr.(int)))
-			if attr, ok := d.GetOk("backup_window"); ok {
-				opts.PreferredBackupWindow = aws.String(attr.(string))
-			}
-			_, err := conn.PromoteReadReplica(&opts)
-			if err != nil {
-				return fmt.Errorf("Error promoting database: %#v", err)
-			}
-			d.Set("replicate_source_db", "")
-		} else {
-			return fmt.Errorf("cannot elect new source database for replication")
-		}
-	}
-
 	if d.HasChange("tags") {
 		if err := setTagsRDS(conn, d, d.Get("arn").(string)); err != nil {
 			return err
@@ -1516,6 +1527,35 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 	return resourceAwsDbInstanceRead(d, meta)
 }
 
+// resourceAwsDbInstanceCustomizeDiff validates read replica promotion at plan
+// time, instead of failing part way through the apply.
+func resourceAwsDbInstanceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("replicate_source_db") || !diff.NewValueKnown("replicate_source_db") {
+		return nil
+	}
+
+	o, n := diff.GetChange("replicate_source_db")
+	oldSource, newSource := o.(string), n.(string)
+
+	if newSource != "" {
+		if oldSource == "" {
+			return fmt.Errorf("cannot convert DB Instance (%s) into a read replica of %q, a new DB Instance must be created", diff.Id(), newSource)
+		}
+
+		return fmt.Errorf("cannot change the source database of read replica (%s) from %q to %q, remove replicate_source_db to promote it first", diff.Id(), oldSource, newSource)
+	}
+
+	if !diff.NewValueKnown("backup_retention_period") {
+		return nil
+	}
+
+	if v := diff.Get("backup_retention_period").(int); v < 0 || v > 35 {
+		return fmt.Errorf("cannot promote read replica (%s): backup_retention_period must be between 0 and 35, got %d", diff.Id(), v)
+	}
+
+	return nil
+}
+
 // resourceAwsDbInstanceRetrieve fetches DBInstance information from the AWS
 // API. It returns an error if there is a communication problem or unexpected
 // error with AWS. When the DBInstance is not found, it returns no error and a
@@ -517,6 +517,47 @@ func TestAccAWSDBInstance_ReplicateSourceDb_BackupRetentionPeriod(t *testing.T)
 	})
 }
 
+func TestAccAWSDBInstance_ReplicateSourceDb_Promote(t *testing.T) {
+	var dbInstance, sourceDbInstance rds.DBInstance
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	sourceResourceName := "aws_db_instance.source"
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBInstanceConfig_ReplicateSourceDb_BackupRetentionPeriod(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists(sourceResourceName, &sourceDbInstance),
+					testAccCheckAWSDBInstanceExists(resourceName, &dbInstance),
+					testAccCheckAWSDBInstanceReplicaAttributes(&sourceDbInstance, &dbInstance),
+				),
+			},
+			{
+				Config:      testAccAWSDBInstanceConfig_ReplicateSourceDb_ChangeSource(rName),
+				ExpectError: regexp.MustCompile(`cannot change the source database of read replica`),
+			},
+			{
+				Config:      testAccAWSDBInstanceConfig_ReplicateSourceDb_Promote(rName, 36),
+				ExpectError: regexp.MustCompile(`backup_retention_period must be between 0 and 35`),
+			},
+			{
+				Config: testAccAWSDBInstanceConfig_ReplicateSourceDb_Promote(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "replicate_source_db", ""),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "3"),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBInstance_ReplicateSourceDb_BackupWindow(t *testing.T) {
 	var dbInstance, sourceDbInstance rds.DBInstance
 
@@ -3510,6 +3551,51 @@ resource "aws_db_instance" "test" {
 `, rName, backupRetentionPeriod, rName)
 }
 
+func testAccAWSDBInstanceConfig_ReplicateSourceDb_ChangeSource(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "source" {
+  allocated_storage       = 5
+  backup_retention_period = 1
+  engine                  = "mysql"
+  identifier              = "%s-source"
+  instance_class          = "db.t2.micro"
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+  skip_final_snapshot     = true
+}
+
+resource "aws_db_instance" "test" {
+  backup_retention_period = 1
+  identifier              = %q
+  instance_class          = "${aws_db_instance.source.instance_class}"
+  replicate_source_db     = "%s-other"
+  skip_final_snapshot     = true
+}
+`, rName, rName, rName)
+}
+
+func testAccAWSDBInstanceConfig_ReplicateSourceDb_Promote(rName string, backupRetentionPeriod int) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "source" {
+  allocated_storage       = 5
+  backup_retention_period = 1
+  engine                  = "mysql"
+  identifier              = "%s-source"
+  instance_class          = "db.t2.micro"
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+  skip_final_snapshot     = true
+}
+
+resource "aws_db_instance" "test" {
+  backup_retention_period = %d
+  identifier              = %q
+  instance_class          = "${aws_db_instance.source.instance_class}"
+  skip_final_snapshot     = true
+}
+`, rName, backupRetentionPeriod, rName)
+}
+
 func testAccAWSDBInstanceConfig_ReplicateSourceDb_BackupWindow(rName, backupWindow string) string {
 	return fmt.Sprintf(`
 resource "aws_db_instance" "source" {
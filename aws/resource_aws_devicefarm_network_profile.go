@@ -0,0 +1,291 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDevicefarmNetworkProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDevicefarmNetworkProfileCreate,
+		Read:   resourceAwsDevicefarmNetworkProfileRead,
+		Update: resourceAwsDevicefarmNetworkProfileUpdate,
+		Delete: resourceAwsDevicefarmNetworkProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  devicefarm.NetworkProfileTypePrivate,
+				ValidateFunc: validation.StringInSlice([]string{
+					devicefarm.NetworkProfileTypeCurated,
+					devicefarm.NetworkProfileTypePrivate,
+				}, false),
+			},
+
+			"downlink_bandwidth_bits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 104857600),
+			},
+
+			"downlink_delay_ms": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 2000),
+			},
+
+			"downlink_jitter_ms": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 2000),
+			},
+
+			"downlink_loss_percent": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+
+			"uplink_bandwidth_bits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 104857600),
+			},
+
+			"uplink_delay_ms": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 2000),
+			},
+
+			"uplink_jitter_ms": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 2000),
+			},
+
+			"uplink_loss_percent": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+		},
+	}
+}
+
+func resourceAwsDevicefarmNetworkProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).devicefarmconn
+
+	input := &devicefarm.CreateNetworkProfileInput{
+		Name:       aws.String(d.Get("name").(string)),
+		ProjectArn: aws.String(d.Get("project_arn").(string)),
+		Type:       aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOkExists("downlink_bandwidth_bits"); ok {
+		input.DownlinkBandwidthBits = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOkExists("downlink_delay_ms"); ok {
+		input.DownlinkDelayMs = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOkExists("downlink_jitter_ms"); ok {
+		input.DownlinkJitterMs = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOkExists("downlink_loss_percent"); ok {
+		input.DownlinkLossPercent = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOkExists("uplink_bandwidth_bits"); ok {
+		input.UplinkBandwidthBits = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOkExists("uplink_delay_ms"); ok {
+		input.UplinkDelayMs = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOkExists("uplink_jitter_ms"); ok {
+		input.UplinkJitterMs = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOkExists("uplink_loss_percent"); ok {
+		input.UplinkLossPercent = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Creating DeviceFarm Network Profile: %s", input)
+	out, err := conn.CreateNetworkProfile(input)
+	if err != nil {
+		return fmt.Errorf("Error creating DeviceFarm Network Profile: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.NetworkProfile.Arn))
+
+	return resourceAwsDevicefarmNetworkProfileRead(d, meta)
+}
+
+func resourceAwsDevicefarmNetworkProfileRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).devicefarmconn
+
+	log.Printf("[DEBUG] Reading DeviceFarm Network Profile: %s", d.Id())
+	out, err := conn.GetNetworkProfile(&devicefarm.GetNetworkProfileInput{
+		Arn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, devicefarm.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] DeviceFarm Network Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading DeviceFarm Network Profile: %s", err)
+	}
+
+	profile := out.NetworkProfile
+	d.Set("arn", profile.Arn)
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("type", profile.Type)
+	d.Set("downlink_bandwidth_bits", profile.DownlinkBandwidthBits)
+	d.Set("downlink_delay_ms", profile.DownlinkDelayMs)
+	d.Set("downlink_jitter_ms", profile.DownlinkJitterMs)
+	d.Set("downlink_loss_percent", profile.DownlinkLossPercent)
+	d.Set("uplink_bandwidth_bits", profile.UplinkBandwidthBits)
+	d.Set("uplink_delay_ms", profile.UplinkDelayMs)
+	d.Set("uplink_jitter_ms", profile.UplinkJitterMs)
+	d.Set("uplink_loss_percent", profile.UplinkLossPercent)
+
+	// The project ARN isn't returned, but shares its project ID with the
+	// network profile ARN.
+	if _, ok := d.GetOk("project_arn"); !ok {
+		projectArn, err := devicefarmProjectArnFromChildArn(d.Id())
+		if err != nil {
+			return err
+		}
+		d.Set("project_arn", projectArn)
+	}
+
+	return nil
+}
+
+func resourceAwsDevicefarmNetworkProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).devicefarmconn
+
+	input := &devicefarm.UpdateNetworkProfileInput{
+		Arn: aws.String(d.Id()),
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+	if d.HasChange("type") {
+		input.Type = aws.String(d.Get("type").(string))
+	}
+	if d.HasChange("downlink_bandwidth_bits") {
+		input.DownlinkBandwidthBits = aws.Int64(int64(d.Get("downlink_bandwidth_bits").(int)))
+	}
+	if d.HasChange("downlink_delay_ms") {
+		input.DownlinkDelayMs = aws.Int64(int64(d.Get("downlink_delay_ms").(int)))
+	}
+	if d.HasChange("downlink_jitter_ms") {
+		input.DownlinkJitterMs = aws.Int64(int64(d.Get("downlink_jitter_ms").(int)))
+	}
+	if d.HasChange("downlink_loss_percent") {
+		input.DownlinkLossPercent = aws.Int64(int64(d.Get("downlink_loss_percent").(int)))
+	}
+	if d.HasChange("uplink_bandwidth_bits") {
+		input.UplinkBandwidthBits = aws.Int64(int64(d.Get("uplink_bandwidth_bits").(int)))
+	}
+	if d.HasChange("uplink_delay_ms") {
+		input.UplinkDelayMs = aws.Int64(int64(d.Get("uplink_delay_ms").(int)))
+	}
+	if d.HasChange("uplink_jitter_ms") {
+		input.UplinkJitterMs = aws.Int64(int64(d.Get("uplink_jitter_ms").(int)))
+	}
+	if d.HasChange("uplink_loss_percent") {
+		input.UplinkLossPercent = aws.Int64(int64(d.Get("uplink_loss_percent").(int)))
+	}
+
+	log.Printf("[DEBUG] Updating DeviceFarm Network Profile: %s", input)
+	if _, err := conn.UpdateNetworkProfile(input); err != nil {
+		return fmt.Errorf("Error updating DeviceFarm Network Profile: %s", err)
+	}
+
+	return resourceAwsDevicefarmNetworkProfileRead(d, meta)
+}
+
+func resourceAwsDevicefarmNetworkProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).devicefarmconn
+
+	log.Printf("[DEBUG] Deleting DeviceFarm Network Profile: %s", d.Id())
+	_, err := conn.DeleteNetworkProfile(&devicefarm.DeleteNetworkProfileInput{
+		Arn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, devicefarm.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error deleting DeviceFarm Network Profile: %s", err)
+	}
+
+	return nil
+}
+
+// devicefarmProjectArnFromChildArn returns the ARN of the project owning a
+// project-scoped DeviceFarm resource, e.g.
+// arn:aws:devicefarm:us-west-2:123456789012:networkprofile:PROJECT-ID/PROFILE-ID.
+func devicefarmProjectArnFromChildArn(v string) (string, error) {
+	parsed, err := arn.Parse(v)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing DeviceFarm ARN (%s): %s", v, err)
+	}
+
+	parts := strings.SplitN(parsed.Resource, ":", 2)
+	if len(parts) != 2 {
+		return "", fmt.Errorf("Unexpected format of DeviceFarm ARN (%s)", v)
+	}
+
+	parsed.Resource = "project:" + strings.SplitN(parts[1], "/", 2)[0]
+
+	return parsed.String(), nil
+}
@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDevicefarmProjectArnFromChildArn(t *testing.T) {
+	projectArn, err := devicefarmProjectArnFromChildArn("arn:aws:devicefarm:us-west-2:123456789012:networkprofile:4fa784c7-ccb4-4dbf-ba4f-02198320daa1/ae69a0b6-1fe5-4ca5-9a1d-4d16a57a57c6")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "arn:aws:devicefarm:us-west-2:123456789012:project:4fa784c7-ccb4-4dbf-ba4f-02198320daa1"
+	if projectArn != expected {
+		t.Fatalf("Expected %q, got %q", expected, projectArn)
+	}
+
+	if _, err := devicefarmProjectArnFromChildArn("arn:aws:devicefarm:us-west-2:123456789012:networkprofile"); err == nil {
+		t.Fatal("Expected an error for an ARN without a project ID")
+	}
+}
+
+func TestAccAWSDeviceFarmNetworkProfile_basic(t *testing.T) {
+	var profile devicefarm.NetworkProfile
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_devicefarm_network_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDeviceFarmNetworkProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceFarmNetworkProfileConfig(rName, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceFarmNetworkProfileExists(resourceName, &profile),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "PRIVATE"),
+					resource.TestCheckResourceAttr(resourceName, "downlink_delay_ms", "100"),
+					resource.TestCheckResourceAttr(resourceName, "uplink_loss_percent", "1"),
+					resource.TestCheckResourceAttr("data.aws_devicefarm_network_profiles.private", "arns.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeviceFarmNetworkProfileConfig(rName, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceFarmNetworkProfileExists(resourceName, &profile),
+					resource.TestCheckResourceAttr(resourceName, "downlink_delay_ms", "200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeviceFarmNetworkProfileExists(n string, v *devicefarm.NetworkProfile) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).devicefarmconn
+		out, err := conn.GetNetworkProfile(&devicefarm.GetNetworkProfileInput{
+			Arn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *out.NetworkProfile
+
+		return nil
+	}
+}
+
+func testAccCheckDeviceFarmNetworkProfileDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).devicefarmconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_devicefarm_network_profile" {
+			continue
+		}
+
+		_, err := conn.GetNetworkProfile(&devicefarm.GetNetworkProfileInput{
+			Arn: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, devicefarm.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DeviceFarm Network Profile (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDeviceFarmNetworkProfileConfig(rName string, delay int) string {
+	return fmt.Sprintf(`
+resource "aws_devicefarm_project" "test" {
+  name = %[1]q
+}
+
+resource "aws_devicefarm_network_profile" "test" {
+  project_arn = "${aws_devicefarm_project.test.arn}"
+  name        = %[1]q
+
+  downlink_bandwidth_bits = 780000
+  downlink_delay_ms       = %[2]d
+  uplink_bandwidth_bits   = 330000
+  uplink_loss_percent     = 1
+}
+
+data "aws_devicefarm_network_profiles" "private" {
+  project_arn = "${aws_devicefarm_network_profile.test.project_arn}"
+  type        = "PRIVATE"
+}
+`, rName, delay)
+}
@@ -0,0 +1,637 @@
+package aws
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDevicefarmRun() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDevicefarmRunCreate,
+		Read:   resourceAwsDevicefarmRunRead,
+		Update: resourceAwsDevicefarmRunUpdate,
+		Delete: resourceAwsDevicefarmRunDelete,
+
+		CustomizeDiff: resourceAwsDevicefarmRunCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(150 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"device_pool_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"app_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"test": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								devicefarm.TestTypeBuiltinFuzz,
+								devicefarm.TestTypeBuiltinExplorer,
+								devicefarm.TestTypeWebPerformanceProfile,
+								devicefarm.TestTypeAppiumJavaJunit,
+								devicefarm.TestTypeAppiumJavaTestng,
+								devicefarm.TestTypeAppiumPython,
+								devicefarm.TestTypeAppiumWebJavaJunit,
+								devicefarm.TestTypeAppiumWebJavaTestng,
+								devicefarm.TestTypeAppiumWebPython,
+								devicefarm.TestTypeCalabash,
+								devicefarm.TestTypeInstrumentation,
+								devicefarm.TestTypeUiautomation,
+								devicefarm.TestTypeUiautomator,
+								devicefarm.TestTypeXctest,
+								devicefarm.TestTypeXctestUi,
+							}, false),
+						},
+						"test_package_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"test_spec_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"filter": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"execution_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts_cleanup": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"app_packages_cleanup": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"job_timeout_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(5, 150),
+						},
+						"skip_app_resign": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"video_capture": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"artifact_export": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"artifacts_exported": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"web_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"counters": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+
+			"artifacts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"extension": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsDevicefarmRunCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).devicefarmconn
+
+	input := &devicefarm.ScheduleRunInput{
+		DevicePoolArn:          aws.String(d.Get("device_pool_arn").(string)),
+		ExecutionConfiguration: expandDevicefarmExecutionConfiguration(d.Get("execution_configuration").([]interface{})),
+		ProjectArn:             aws.String(d.Get("project_arn").(string)),
+		Test:                   expandDevicefarmScheduleRunTest(d.Get("test").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("app_arn"); ok {
+		input.AppArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Scheduling DeviceFarm Run: %s", input)
+	out, err := conn.ScheduleRun(input)
+	if err != nil {
+		return fmt.Errorf("Error scheduling DeviceFarm Run: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.Run.Arn))
+
+	if d.Get("wait_for_completion").(bool) {
+		log.Printf("[DEBUG] Waiting for DeviceFarm Run (%s) to complete", d.Id())
+		if err := waitForDevicefarmRunCompletion(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("Error waiting for DeviceFarm Run (%s) to complete: %s", d.Id(), err)
+		}
+
+		if err := resourceAwsDevicefarmRunExportArtifacts(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsDevicefarmRunRead(d, meta)
+}
+
+func resourceAwsDevicefarmRunRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).devicefarmconn
+
+	log.Printf("[DEBUG] Reading DeviceFarm Run: %s", d.Id())
+	out, err := conn.GetRun(&devicefarm.GetRunInput{
+		Arn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, devicefarm.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] DeviceFarm Run (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading DeviceFarm Run: %s", err)
+	}
+
+	run := out.Run
+	d.Set("arn", run.Arn)
+	d.Set("device_pool_arn", run.DevicePoolArn)
+	d.Set("message", run.Message)
+	d.Set("name", run.Name)
+	d.Set("platform", run.Platform)
+	d.Set("result", run.Result)
+	d.Set("status", run.Status)
+	d.Set("web_url", run.WebUrl)
+
+	if err := d.Set("counters", flattenDevicefarmCounters(run.Counters)); err != nil {
+		return fmt.Errorf("error setting counters: %s", err)
+	}
+
+	artifacts := make([]interface{}, 0)
+	if aws.StringValue(run.Status) == devicefarm.ExecutionStatusCompleted {
+		artifacts, err = listDevicefarmRunArtifacts(conn, d.Id())
+		if err != nil {
+			return fmt.Errorf("Error listing DeviceFarm Run (%s) artifacts: %s", d.Id(), err)
+		}
+	}
+
+	if err := d.Set("artifacts", artifacts); err != nil {
+		return fmt.Errorf("error setting artifacts: %s", err)
+	}
+
+	return nil
+}
+
+// resourceAwsDevicefarmRunUpdate only handles wait_for_completion and the
+// artifact export of runs that completed after the apply that scheduled them,
+// every other argument schedules a new run.
+func resourceAwsDevicefarmRunUpdate(d *schema.ResourceData, meta interface{}) error {
+	if exported, _ := d.GetChange("artifacts_exported"); !exported.(bool) && d.Get("status").(string) == devicefarm.ExecutionStatusCompleted {
+		if err := resourceAwsDevicefarmRunExportArtifacts(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsDevicefarmRunRead(d, meta)
+}
+
+// resourceAwsDevicefarmRunCustomizeDiff plans the artifact export once a run
+// scheduled without waiting for it has completed.
+func resourceAwsDevicefarmRunCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || len(diff.Get("artifact_export").([]interface{})) == 0 {
+		return nil
+	}
+
+	if diff.Get("status").(string) == devicefarm.ExecutionStatusCompleted && !diff.Get("artifacts_exported").(bool) {
+		return diff.SetNew("artifacts_exported", true)
+	}
+
+	return nil
+}
+
+func resourceAwsDevicefarmRunDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).devicefarmconn
+
+	out, err := conn.GetRun(&devicefarm.GetRunInput{
+		Arn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, devicefarm.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading DeviceFarm Run (%s): %s", d.Id(), err)
+	}
+
+	// Runs cannot be deleted while they are still executing.
+	if status := aws.StringValue(out.Run.Status); status != devicefarm.ExecutionStatusCompleted {
+		log.Printf("[DEBUG] Stopping DeviceFarm Run: %s", d.Id())
+		_, err := conn.StopRun(&devicefarm.StopRunInput{
+			Arn: aws.String(d.Id()),
+		})
+		if isAWSErr(err, devicefarm.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("Error stopping DeviceFarm Run (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForDevicefarmRunCompletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return fmt.Errorf("Error waiting for DeviceFarm Run (%s) to stop: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting DeviceFarm Run: %s", d.Id())
+	_, err = conn.DeleteRun(&devicefarm.DeleteRunInput{
+		Arn: aws.String(d.Id()),
+	})
+	if isAWSErr(err, devicefarm.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error deleting DeviceFarm Run: %s", err)
+	}
+
+	return nil
+}
+
+func devicefarmRunRefreshFunc(conn *devicefarm.DeviceFarm, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := conn.GetRun(&devicefarm.GetRunInput{
+			Arn: aws.String(arn),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		run := out.Run
+		log.Printf("[INFO] DeviceFarm Run (%s) status: %s, completed jobs: %d/%d",
+			arn, aws.StringValue(run.Status), aws.Int64Value(run.CompletedJobs), aws.Int64Value(run.TotalJobs))
+
+		return run, aws.StringValue(run.Status), nil
+	}
+}
+
+// waitForDevicefarmRunCompletion waits until the run has completed, whatever
+// its result. Test failures are reported through result and counters.
+func waitForDevicefarmRunCompletion(conn *devicefarm.DeviceFarm, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			devicefarm.ExecutionStatusPending,
+			devicefarm.ExecutionStatusPendingConcurrency,
+			devicefarm.ExecutionStatusPendingDevice,
+			devicefarm.ExecutionStatusProcessing,
+			devicefarm.ExecutionStatusScheduling,
+			devicefarm.ExecutionStatusPreparing,
+			devicefarm.ExecutionStatusRunning,
+			devicefarm.ExecutionStatusStopping,
+		},
+		Target:     []string{devicefarm.ExecutionStatusCompleted},
+		Refresh:    devicefarmRunRefreshFunc(conn, arn),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func listDevicefarmRunArtifacts(conn *devicefarm.DeviceFarm, arn string) ([]interface{}, error) {
+	artifacts := make([]interface{}, 0)
+
+	for _, category := range []string{devicefarm.ArtifactCategoryFile, devicefarm.ArtifactCategoryLog, devicefarm.ArtifactCategoryScreenshot} {
+		input := &devicefarm.ListArtifactsInput{
+			Arn:  aws.String(arn),
+			Type: aws.String(category),
+		}
+
+		err := conn.ListArtifactsPages(input, func(page *devicefarm.ListArtifactsOutput, lastPage bool) bool {
+			for _, artifact := range page.Artifacts {
+				artifacts = append(artifacts, map[string]interface{}{
+					"arn":       aws.StringValue(artifact.Arn),
+					"extension": aws.StringValue(artifact.Extension),
+					"name":      aws.StringValue(artifact.Name),
+					"type":      aws.StringValue(artifact.Type),
+					"url":       aws.StringValue(artifact.Url),
+				})
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return artifacts, nil
+}
+
+// resourceAwsDevicefarmRunExportArtifacts copies the artifacts of a completed
+// run to the configured S3 bucket.
+func resourceAwsDevicefarmRunExportArtifacts(d *schema.ResourceData, meta interface{}) error {
+	v, ok := d.GetOk("artifact_export")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	m := v.([]interface{})[0].(map[string]interface{})
+	bucket := m["bucket"].(string)
+	prefix := m["prefix"].(string)
+
+	artifacts, err := listDevicefarmRunArtifacts(meta.(*AWSClient).devicefarmconn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error listing DeviceFarm Run (%s) artifacts: %s", d.Id(), err)
+	}
+
+	for _, a := range artifacts {
+		artifact := a.(map[string]interface{})
+		key := devicefarmRunArtifactS3Key(prefix, artifact["arn"].(string), artifact["name"].(string), artifact["extension"].(string))
+
+		log.Printf("[DEBUG] Exporting DeviceFarm Run (%s) artifact %s to s3://%s/%s", d.Id(), artifact["arn"], bucket, key)
+		if err := copyDevicefarmArtifactToS3(meta.(*AWSClient).s3conn, artifact["url"].(string), bucket, key); err != nil {
+			return fmt.Errorf("Error exporting DeviceFarm Run (%s) artifact %s: %s", d.Id(), artifact["arn"], err)
+		}
+	}
+
+	d.Set("artifacts_exported", true)
+
+	return nil
+}
+
+// devicefarmRunArtifactS3Key keys artifacts by the job, suite and test path
+// of their ARN, as artifact names such as "Logcat" repeat within a run.
+func devicefarmRunArtifactS3Key(prefix, arn, name, extension string) string {
+	key := prefix
+	if i := strings.Index(arn, ":artifact:"); i >= 0 {
+		key += arn[i+len(":artifact:"):] + "/"
+	}
+
+	key += name
+	if extension != "" {
+		key += "." + extension
+	}
+
+	return key
+}
+
+// copyDevicefarmArtifactToS3 downloads the artifact to a temporary file, as
+// PutObject needs a seekable body, and uploads it to S3.
+func copyDevicefarmArtifactToS3(conn *s3.S3, url, bucket, key string) error {
+	resp, err := cleanhttp.DefaultClient().Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status downloading artifact: %s", resp.Status)
+	}
+
+	f, err := ioutil.TempFile("", "terraform-devicefarm-artifact")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	_, err = conn.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   f,
+	})
+
+	return err
+}
+
+func expandDevicefarmScheduleRunTest(l []interface{}) *devicefarm.ScheduleRunTest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	test := &devicefarm.ScheduleRunTest{
+		Type: aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["test_package_arn"].(string); ok && v != "" {
+		test.TestPackageArn = aws.String(v)
+	}
+
+	if v, ok := m["test_spec_arn"].(string); ok && v != "" {
+		test.TestSpecArn = aws.String(v)
+	}
+
+	if v, ok := m["filter"].(string); ok && v != "" {
+		test.Filter = aws.String(v)
+	}
+
+	if v, ok := m["parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		test.Parameters = stringMapToPointers(v)
+	}
+
+	return test
+}
+
+func expandDevicefarmExecutionConfiguration(l []interface{}) *devicefarm.ExecutionConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &devicefarm.ExecutionConfiguration{
+		AccountsCleanup:    aws.Bool(m["accounts_cleanup"].(bool)),
+		AppPackagesCleanup: aws.Bool(m["app_packages_cleanup"].(bool)),
+		SkipAppResign:      aws.Bool(m["skip_app_resign"].(bool)),
+		VideoCapture:       aws.Bool(m["video_capture"].(bool)),
+	}
+
+	if v, ok := m["job_timeout_minutes"].(int); ok && v > 0 {
+		config.JobTimeoutMinutes = aws.Int64(int64(v))
+	}
+
+	return config
+}
+
+func flattenDevicefarmCounters(counters *devicefarm.Counters) map[string]interface{} {
+	if counters == nil {
+		return map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"errored": int(aws.Int64Value(counters.Errored)),
+		"failed":  int(aws.Int64Value(counters.Failed)),
+		"passed":  int(aws.Int64Value(counters.Passed)),
+		"skipped": int(aws.Int64Value(counters.Skipped)),
+		"stopped": int(aws.Int64Value(counters.Stopped)),
+		"total":   int(aws.Int64Value(counters.Total)),
+		"warned":  int(aws.Int64Value(counters.Warned)),
+	}
+}
@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Device Farm runs need an uploaded application and a device pool in an
+// existing project, which cannot be managed by Terraform yet.
+func testAccPreCheckAWSDeviceFarmRun(t *testing.T) {
+	for _, key := range []string{"DEVICEFARM_PROJECT_ARN", "DEVICEFARM_DEVICE_POOL_ARN", "DEVICEFARM_APP_ARN"} {
+		if os.Getenv(key) == "" {
+			t.Skipf("Environment variable %s is not set", key)
+		}
+	}
+}
+
+func TestAccAWSDeviceFarmRun_basic(t *testing.T) {
+	var run devicefarm.Run
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_devicefarm_run.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccHasServicePreCheck(endpoints.DevicefarmServiceID, t)
+			testAccPreCheckAWSDeviceFarmRun(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDeviceFarmRunDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceFarmRunConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceFarmRunExists(resourceName, &run),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "test.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "test.0.type", "BUILTIN_FUZZ"),
+					resource.TestCheckResourceAttr(resourceName, "execution_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "execution_configuration.0.job_timeout_minutes", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDeviceFarmRun_artifactExport(t *testing.T) {
+	var run devicefarm.Run
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_devicefarm_run.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccHasServicePreCheck(endpoints.DevicefarmServiceID, t)
+			testAccPreCheckAWSDeviceFarmRun(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDeviceFarmRunDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceFarmRunConfigArtifactExport(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceFarmRunExists(resourceName, &run),
+					resource.TestCheckResourceAttr(resourceName, "status", devicefarm.ExecutionStatusCompleted),
+					resource.TestCheckResourceAttr(resourceName, "artifacts_exported", "true"),
+					testAccCheckDeviceFarmRunArtifactsExported(rName),
+				),
+			},
+		},
+	})
+}
+
+func TestDevicefarmRunArtifactS3Key(t *testing.T) {
+	cases := []struct {
+		Prefix    string
+		Arn       string
+		Name      string
+		Extension string
+		Expected  string
+	}{
+		{
+			"runs/",
+			"arn:aws:devicefarm:us-west-2:123456789012:artifact:1b2c3d4e/5f6a7b8c/00000/00001/00002/00003",
+			"Logcat",
+			"logcat",
+			"runs/1b2c3d4e/5f6a7b8c/00000/00001/00002/00003/Logcat.logcat",
+		},
+		{
+			"",
+			"arn:aws:devicefarm:us-west-2:123456789012:artifact:1b2c3d4e/00000",
+			"Video",
+			"",
+			"1b2c3d4e/00000/Video",
+		},
+		{
+			"runs/",
+			"not-an-arn",
+			"Screenshot",
+			"png",
+			"runs/Screenshot.png",
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := devicefarmRunArtifactS3Key(tc.Prefix, tc.Arn, tc.Name, tc.Extension); actual != tc.Expected {
+			t.Fatalf("expected %q, got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func testAccCheckDeviceFarmRunArtifactsExported(bucket string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		resp, err := conn.ListObjects(&s3.ListObjectsInput{
+			Bucket: aws.String(bucket),
+			Prefix: aws.String("runs/"),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Contents) == 0 {
+			return fmt.Errorf("no DeviceFarm Run artifacts exported to S3 bucket %s", bucket)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeviceFarmRunExists(n string, v *devicefarm.Run) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).devicefarmconn
+		resp, err := conn.GetRun(&devicefarm.GetRunInput{Arn: aws.String(rs.Primary.ID)})
+		if err != nil {
+			return err
+		}
+		if resp.Run == nil {
+			return fmt.Errorf("DeviceFarm Run not found")
+		}
+
+		*v = *resp.Run
+
+		return nil
+	}
+}
+
+func testAccCheckDeviceFarmRunDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).devicefarmconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_devicefarm_run" {
+			continue
+		}
+
+		resp, err := conn.GetRun(&devicefarm.GetRunInput{Arn: aws.String(rs.Primary.ID)})
+		if isAWSErr(err, devicefarm.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if resp.Run != nil {
+			return fmt.Errorf("DeviceFarm Run (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccDeviceFarmRunConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_devicefarm_run" "test" {
+  name            = %q
+  project_arn     = %q
+  device_pool_arn = %q
+  app_arn         = %q
+
+  test {
+    type = "BUILTIN_FUZZ"
+
+    parameters = {
+      event_count = "100"
+    }
+  }
+
+  execution_configuration {
+    job_timeout_minutes = 10
+  }
+}
+`, rName, os.Getenv("DEVICEFARM_PROJECT_ARN"), os.Getenv("DEVICEFARM_DEVICE_POOL_ARN"), os.Getenv("DEVICEFARM_APP_ARN"))
+}
+
+func testAccDeviceFarmRunConfigArtifactExport(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_devicefarm_run" "test" {
+  name            = %[1]q
+  project_arn     = %[2]q
+  device_pool_arn = %[3]q
+  app_arn         = %[4]q
+
+  test {
+    type = "BUILTIN_FUZZ"
+  }
+
+  execution_configuration {
+    job_timeout_minutes = 5
+  }
+
+  artifact_export {
+    bucket = "${aws_s3_bucket.test.id}"
+    prefix = "runs/"
+  }
+
+  wait_for_completion = true
+}
+`, rName, os.Getenv("DEVICEFARM_PROJECT_ARN"), os.Getenv("DEVICEFARM_DEVICE_POOL_ARN"), os.Getenv("DEVICEFARM_APP_ARN"))
+}
@@ -3,21 +3,24 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsDmsCertificate() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsDmsCertificateCreate,
 		Read:   resourceAwsDmsCertificateRead,
+		Update: resourceAwsDmsCertificateRead,
 		Delete: resourceAwsDmsCertificateDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsDmsCertificateImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,6 +46,20 @@ func resourceAwsDmsCertificate() *schema.Resource {
 				ForceNew:  true,
 				Sensitive: true,
 			},
+			"expiry_warning_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"valid_from_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"valid_to_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -121,6 +138,12 @@ func resourceAwsDmsCertificateDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+func resourceAwsDmsCertificateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// expiry_warning_days is not stored in AWS, so it starts at its default.
+	d.Set("expiry_warning_days", 30)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsDmsCertificateSetState(d *schema.ResourceData, cert *dms.Certificate) error {
 	d.SetId(*cert.CertificateIdentifier)
 
@@ -134,5 +157,28 @@ func resourceAwsDmsCertificateSetState(d *schema.ResourceData, cert *dms.Certifi
 		d.Set("certificate_wallet", cert.CertificateWallet)
 	}
 
+	d.Set("valid_from_date", "")
+	if cert.ValidFromDate != nil {
+		d.Set("valid_from_date", aws.TimeValue(cert.ValidFromDate).Format(time.RFC3339))
+	}
+	d.Set("valid_to_date", "")
+	if cert.ValidToDate != nil {
+		d.Set("valid_to_date", aws.TimeValue(cert.ValidToDate).Format(time.RFC3339))
+
+		if days := d.Get("expiry_warning_days").(int); days > 0 && dmsCertificateExpiresWithin(cert, time.Now(), days) {
+			log.Printf("[WARN] DMS certificate (%s) expires on %s, within %d days", d.Id(), d.Get("valid_to_date"), days)
+		}
+	}
+
 	return nil
 }
+
+// dmsCertificateExpiresWithin returns whether the certificate expires within
+// the given number of days from now.
+func dmsCertificateExpiresWithin(cert *dms.Certificate, now time.Time, days int) bool {
+	if cert.ValidToDate == nil {
+		return false
+	}
+
+	return aws.TimeValue(cert.ValidToDate).Before(now.AddDate(0, 0, days))
+}
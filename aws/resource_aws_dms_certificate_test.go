@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
@@ -26,6 +27,8 @@ func TestAccAWSDmsCertificateBasic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					checkDmsCertificateExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "certificate_arn"),
+					resource.TestCheckResourceAttr(resourceName, "valid_from_date", "2017-01-30T19:20:08Z"),
+					resource.TestCheckResourceAttr(resourceName, "valid_to_date", "2026-12-09T19:20:08Z"),
 				),
 			},
 			{
@@ -37,6 +40,28 @@ func TestAccAWSDmsCertificateBasic(t *testing.T) {
 	})
 }
 
+func TestDmsCertificateExpiresWithin(t *testing.T) {
+	now := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		ValidToDate *time.Time
+		Days        int
+		Expected    bool
+	}{
+		{nil, 30, false},
+		{aws.Time(now.AddDate(0, 0, 10)), 30, true},
+		{aws.Time(now.AddDate(0, 0, 45)), 30, false},
+		{aws.Time(now.AddDate(0, 0, -1)), 30, true},
+	}
+
+	for _, tc := range cases {
+		cert := &dms.Certificate{ValidToDate: tc.ValidToDate}
+		if actual := dmsCertificateExpiresWithin(cert, now, tc.Days); actual != tc.Expected {
+			t.Fatalf("expected %t for %v within %d days, got %t", tc.Expected, tc.ValidToDate, tc.Days, actual)
+		}
+	}
+}
+
 func dmsCertificateDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dms_certificate" {
@@ -182,6 +182,7 @@ func resourceAwsDmsReplicationInstanceCreate(d *schema.ResourceData, meta interf
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
+	logStateChangeConf("aws_dms_replication_instance", d.Id(), stateConf)
 
 	// Wait, catching any errors
 	_, err = stateConf.WaitForState()
@@ -340,6 +341,7 @@ func resourceAwsDmsReplicationInstanceUpdate(d *schema.ResourceData, meta interf
 			MinTimeout: 10 * time.Second,
 			Delay:      30 * time.Second, // Wait 30 secs before starting
 		}
+		logStateChangeConf("aws_dms_replication_instance", d.Id(), stateConf)
 
 		// Wait, catching any errors
 		_, err = stateConf.WaitForState()
@@ -378,6 +380,7 @@ func resourceAwsDmsReplicationInstanceDelete(d *schema.ResourceData, meta interf
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
+	logStateChangeConf("aws_dms_replication_instance", d.Id(), stateConf)
 
 	// Wait, catching any errors
 	_, err = stateConf.WaitForState()
@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDmsTestConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDmsTestConnectionCreate,
+		Read:   resourceAwsDmsTestConnectionRead,
+		Delete: resourceAwsDmsTestConnectionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"endpoint_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"replication_instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"last_failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDmsTestConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	endpointArn := d.Get("endpoint_arn").(string)
+	replicationInstanceArn := d.Get("replication_instance_arn").(string)
+
+	request := &dms.TestConnectionInput{
+		EndpointArn:            aws.String(endpointArn),
+		ReplicationInstanceArn: aws.String(replicationInstanceArn),
+	}
+
+	log.Println("[DEBUG] DMS test connection:", request)
+	if _, err := conn.TestConnection(request); err != nil {
+		return fmt.Errorf("Error testing DMS connection between replication instance (%s) and endpoint (%s): %s", replicationInstanceArn, endpointArn, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", replicationInstanceArn, endpointArn))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"testing"},
+		Target:     []string{"successful"},
+		Refresh:    resourceAwsDmsTestConnectionStateRefreshFunc(conn, replicationInstanceArn, endpointArn),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	// Removing the resource from state lets the connection be tested again
+	// on the next apply.
+	if _, err := stateConf.WaitForState(); err != nil {
+		d.SetId("")
+		return fmt.Errorf("DMS connection between replication instance (%s) and endpoint (%s) failed: %s", replicationInstanceArn, endpointArn, err)
+	}
+
+	return resourceAwsDmsTestConnectionRead(d, meta)
+}
+
+func resourceAwsDmsTestConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	c, err := findDmsConnection(conn, d.Get("replication_instance_arn").(string), d.Get("endpoint_arn").(string))
+	if err != nil {
+		return fmt.Errorf("Error reading DMS connection (%s): %s", d.Id(), err)
+	}
+	if c == nil {
+		log.Printf("[WARN] DMS connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("last_failure_message", c.LastFailureMessage)
+	d.Set("status", c.Status)
+
+	return nil
+}
+
+func resourceAwsDmsTestConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	// The tested connection is kept by DMS; there is nothing to delete.
+	return nil
+}
+
+func resourceAwsDmsTestConnectionStateRefreshFunc(conn *dms.DatabaseMigrationService, replicationInstanceArn, endpointArn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		c, err := findDmsConnection(conn, replicationInstanceArn, endpointArn)
+		if err != nil {
+			return nil, "", err
+		}
+		if c == nil {
+			return nil, "", nil
+		}
+
+		status := aws.StringValue(c.Status)
+		if status == "failed" {
+			return c, status, fmt.Errorf("%s", aws.StringValue(c.LastFailureMessage))
+		}
+
+		return c, status, nil
+	}
+}
+
+func findDmsConnection(conn *dms.DatabaseMigrationService, replicationInstanceArn, endpointArn string) (*dms.Connection, error) {
+	resp, err := conn.DescribeConnections(&dms.DescribeConnectionsInput{
+		Filters: []*dms.Filter{
+			{
+				Name:   aws.String("endpoint-arn"),
+				Values: []*string{aws.String(endpointArn)},
+			},
+			{
+				Name:   aws.String("replication-instance-arn"),
+				Values: []*string{aws.String(replicationInstanceArn)},
+			},
+		},
+	})
+	if isAWSErr(err, dms.ErrCodeResourceNotFoundFault, "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range resp.Connections {
+		if aws.StringValue(c.EndpointArn) == endpointArn && aws.StringValue(c.ReplicationInstanceArn) == replicationInstanceArn {
+			return c, nil
+		}
+	}
+
+	return nil, nil
+}
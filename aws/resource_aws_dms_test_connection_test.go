@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The endpoint points at a non-existent server, so the connection test is
+// expected to fail and surface the DMS failure message.
+func TestAccAWSDmsTestConnectionFailure(t *testing.T) {
+	randId := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      dmsTestConnectionConfig(randId),
+				ExpectError: regexp.MustCompile(`DMS connection between replication instance .* failed`),
+			},
+		},
+	})
+}
+
+func dmsTestConnectionConfig(randId string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "dms_vpc" {
+	cidr_block = "10.1.0.0/16"
+	tags {
+		Name = "terraform-testacc-dms-test-connection"
+	}
+}
+
+resource "aws_subnet" "dms_subnet_1" {
+	cidr_block = "10.1.1.0/24"
+	availability_zone = "us-west-2a"
+	vpc_id = "${aws_vpc.dms_vpc.id}"
+	tags {
+		Name = "tf-acc-dms-test-connection-1"
+	}
+}
+
+resource "aws_subnet" "dms_subnet_2" {
+	cidr_block = "10.1.2.0/24"
+	availability_zone = "us-west-2b"
+	vpc_id = "${aws_vpc.dms_vpc.id}"
+	tags {
+		Name = "tf-acc-dms-test-connection-2"
+	}
+}
+
+resource "aws_dms_endpoint" "dms_endpoint" {
+	database_name = "tf-test-dms-db"
+	endpoint_id = "tf-test-dms-endpoint-%[1]s"
+	endpoint_type = "source"
+	engine_name = "aurora"
+	server_name = "tf-test-cluster.cluster-xxxxxxx.us-west-2.rds.amazonaws.com"
+	port = 3306
+	username = "tftest"
+	password = "tftest"
+}
+
+resource "aws_dms_replication_subnet_group" "dms_replication_subnet_group" {
+	replication_subnet_group_id = "tf-test-dms-replication-subnet-group-%[1]s"
+	replication_subnet_group_description = "terraform test for connection testing"
+	subnet_ids = ["${aws_subnet.dms_subnet_1.id}", "${aws_subnet.dms_subnet_2.id}"]
+}
+
+resource "aws_dms_replication_instance" "dms_replication_instance" {
+	allocated_storage = 5
+	replication_instance_class = "dms.t2.micro"
+	replication_instance_id = "tf-test-dms-replication-instance-%[1]s"
+	publicly_accessible = false
+	replication_subnet_group_id = "${aws_dms_replication_subnet_group.dms_replication_subnet_group.replication_subnet_group_id}"
+}
+
+resource "aws_dms_test_connection" "test" {
+	endpoint_arn = "${aws_dms_endpoint.dms_endpoint.endpoint_arn}"
+	replication_instance_arn = "${aws_dms_replication_instance.dms_replication_instance.replication_instance_arn}"
+}
+`, randId)
+}
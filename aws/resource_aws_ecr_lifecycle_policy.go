@@ -1,12 +1,26 @@
 package aws
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+const (
+	ecrLifecyclePolicyMaxRules = 50
+
+	ecrLifecyclePolicyTagStatusTagged   = "tagged"
+	ecrLifecyclePolicyTagStatusUntagged = "untagged"
+	ecrLifecyclePolicyTagStatusAny      = "any"
+
+	ecrLifecyclePolicyCountTypeImageCountMoreThan = "imageCountMoreThan"
+	ecrLifecyclePolicyCountTypeSinceImagePushed   = "sinceImagePushed"
+)
+
 func resourceAwsEcrLifecyclePolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsEcrLifecyclePolicyCreate,
@@ -17,6 +31,8 @@ func resourceAwsEcrLifecyclePolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsEcrLifecyclePolicyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"repository": {
 				Type:     schema.TypeString,
@@ -25,11 +41,82 @@ func resourceAwsEcrLifecyclePolicy() *schema.Resource {
 			},
 			"policy": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
 				ForceNew:         true,
+				ConflictsWith:    []string{"rule"},
 				ValidateFunc:     validation.ValidateJsonString,
 				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
+			"rule": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      ecrLifecyclePolicyMaxRules,
+				ConflictsWith: []string{"policy"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"selection": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_status": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ecrLifecyclePolicyTagStatusTagged,
+											ecrLifecyclePolicyTagStatusUntagged,
+											ecrLifecyclePolicyTagStatusAny,
+										}, false),
+									},
+									"tag_prefix_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"count_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ecrLifecyclePolicyCountTypeImageCountMoreThan,
+											ecrLifecyclePolicyCountTypeSinceImagePushed,
+										}, false),
+									},
+									"count_unit": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"days"}, false),
+									},
+									"count_number": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"registry_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -38,12 +125,38 @@ func resourceAwsEcrLifecyclePolicy() *schema.Resource {
 	}
 }
 
+func resourceAwsEcrLifecyclePolicyCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("rule") {
+		return nil
+	}
+
+	rules := diff.Get("rule").([]interface{})
+
+	// Existing resources may have been imported without either argument.
+	if diff.Id() == "" && diff.NewValueKnown("policy") {
+		if _, ok := diff.GetOk("policy"); !ok && len(rules) == 0 {
+			return fmt.Errorf("one of policy or rule must be configured")
+		}
+	}
+
+	return validateEcrLifecyclePolicyRules(rules)
+}
+
 func resourceAwsEcrLifecyclePolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecrconn
 
+	policy := d.Get("policy").(string)
+	if v, ok := d.GetOk("rule"); ok {
+		var err error
+		policy, err = expandEcrLifecyclePolicyRules(v.([]interface{}))
+		if err != nil {
+			return fmt.Errorf("error building ECR Lifecycle Policy: %s", err)
+		}
+	}
+
 	input := &ecr.PutLifecyclePolicyInput{
 		RepositoryName:      aws.String(d.Get("repository").(string)),
-		LifecyclePolicyText: aws.String(d.Get("policy").(string)),
+		LifecyclePolicyText: aws.String(policy),
 	}
 
 	resp, err := conn.PutLifecyclePolicy(input)
@@ -79,6 +192,18 @@ func resourceAwsEcrLifecyclePolicyRead(d *schema.ResourceData, meta interface{})
 	d.Set("registry_id", resp.RegistryId)
 	d.Set("policy", resp.LifecyclePolicyText)
 
+	// Only refresh the rules when they are managed, so that changes made
+	// outside of Terraform show up as a diff.
+	if len(d.Get("rule").([]interface{})) > 0 {
+		rules, err := flattenEcrLifecyclePolicyRules(aws.StringValue(resp.LifecyclePolicyText))
+		if err != nil {
+			return fmt.Errorf("error reading ECR Lifecycle Policy (%s) rules: %s", d.Id(), err)
+		}
+		if err := d.Set("rule", rules); err != nil {
+			return fmt.Errorf("error setting rule: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -102,3 +227,158 @@ func resourceAwsEcrLifecyclePolicyDelete(d *schema.ResourceData, meta interface{
 
 	return nil
 }
+
+type ecrLifecyclePolicy struct {
+	Rules []*ecrLifecyclePolicyRule `json:"rules"`
+}
+
+type ecrLifecyclePolicyRule struct {
+	RulePriority int                          `json:"rulePriority"`
+	Description  string                       `json:"description,omitempty"`
+	Selection    *ecrLifecyclePolicySelection `json:"selection"`
+	Action       *ecrLifecyclePolicyAction    `json:"action"`
+}
+
+type ecrLifecyclePolicySelection struct {
+	TagStatus     string   `json:"tagStatus"`
+	TagPrefixList []string `json:"tagPrefixList,omitempty"`
+	CountType     string   `json:"countType"`
+	CountUnit     string   `json:"countUnit,omitempty"`
+	CountNumber   int      `json:"countNumber"`
+}
+
+type ecrLifecyclePolicyAction struct {
+	Type string `json:"type"`
+}
+
+// validateEcrLifecyclePolicyRules checks the rules against the constraints
+// ECR enforces when the policy is put, so they are reported at plan time.
+func validateEcrLifecyclePolicyRules(l []interface{}) error {
+	if len(l) > ecrLifecyclePolicyMaxRules {
+		return fmt.Errorf("a lifecycle policy supports at most %d rules, got %d", ecrLifecyclePolicyMaxRules, len(l))
+	}
+
+	priorities := make(map[int]bool)
+	maxPriority := 0
+	anyPriority := 0
+
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		m := raw.(map[string]interface{})
+		priority := m["priority"].(int)
+
+		if priorities[priority] {
+			return fmt.Errorf("rule priority %d is not unique", priority)
+		}
+		priorities[priority] = true
+		if priority > maxPriority {
+			maxPriority = priority
+		}
+
+		selections := m["selection"].([]interface{})
+		if len(selections) == 0 || selections[0] == nil {
+			continue
+		}
+		s := selections[0].(map[string]interface{})
+
+		tagStatus := s["tag_status"].(string)
+		tagPrefixes := s["tag_prefix_list"].([]interface{})
+		switch {
+		case tagStatus == ecrLifecyclePolicyTagStatusTagged && len(tagPrefixes) == 0:
+			return fmt.Errorf("rule %d: tag_prefix_list is required when tag_status is %q", priority, tagStatus)
+		case tagStatus != ecrLifecyclePolicyTagStatusTagged && len(tagPrefixes) > 0:
+			return fmt.Errorf("rule %d: tag_prefix_list can only be set when tag_status is %q", priority, ecrLifecyclePolicyTagStatusTagged)
+		}
+
+		if tagStatus == ecrLifecyclePolicyTagStatusAny {
+			anyPriority = priority
+		}
+
+		countType := s["count_type"].(string)
+		countUnit := s["count_unit"].(string)
+		switch {
+		case countType == ecrLifecyclePolicyCountTypeSinceImagePushed && countUnit == "":
+			return fmt.Errorf("rule %d: count_unit is required when count_type is %q", priority, countType)
+		case countType == ecrLifecyclePolicyCountTypeImageCountMoreThan && countUnit != "":
+			return fmt.Errorf("rule %d: count_unit cannot be set when count_type is %q", priority, countType)
+		}
+	}
+
+	if anyPriority != 0 && anyPriority != maxPriority {
+		return fmt.Errorf("rule %d: a rule with tag_status %q must have the highest priority value", anyPriority, ecrLifecyclePolicyTagStatusAny)
+	}
+
+	return nil
+}
+
+func expandEcrLifecyclePolicyRules(l []interface{}) (string, error) {
+	policy := &ecrLifecyclePolicy{
+		Rules: make([]*ecrLifecyclePolicyRule, 0, len(l)),
+	}
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		s := m["selection"].([]interface{})[0].(map[string]interface{})
+
+		selection := &ecrLifecyclePolicySelection{
+			TagStatus:   s["tag_status"].(string),
+			CountType:   s["count_type"].(string),
+			CountUnit:   s["count_unit"].(string),
+			CountNumber: s["count_number"].(int),
+		}
+		for _, prefix := range s["tag_prefix_list"].([]interface{}) {
+			selection.TagPrefixList = append(selection.TagPrefixList, prefix.(string))
+		}
+
+		policy.Rules = append(policy.Rules, &ecrLifecyclePolicyRule{
+			RulePriority: m["priority"].(int),
+			Description:  m["description"].(string),
+			Selection:    selection,
+			Action:       &ecrLifecyclePolicyAction{Type: "expire"},
+		})
+	}
+
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func flattenEcrLifecyclePolicyRules(policyText string) ([]interface{}, error) {
+	policy := &ecrLifecyclePolicy{}
+	if err := json.Unmarshal([]byte(policyText), policy); err != nil {
+		return nil, err
+	}
+
+	rules := make([]interface{}, 0, len(policy.Rules))
+	for _, rule := range policy.Rules {
+		if rule == nil || rule.Selection == nil {
+			continue
+		}
+
+		tagPrefixes := make([]interface{}, 0, len(rule.Selection.TagPrefixList))
+		for _, prefix := range rule.Selection.TagPrefixList {
+			tagPrefixes = append(tagPrefixes, prefix)
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"priority":    rule.RulePriority,
+			"description": rule.Description,
+			"selection": []interface{}{
+				map[string]interface{}{
+					"tag_status":      rule.Selection.TagStatus,
+					"tag_prefix_list": tagPrefixes,
+					"count_type":      rule.Selection.CountType,
+					"count_unit":      rule.Selection.CountUnit,
+					"count_number":    rule.Selection.CountNumber,
+				},
+			},
+		})
+	}
+
+	return rules, nil
+}
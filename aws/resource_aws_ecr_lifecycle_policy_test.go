@@ -2,6 +2,9 @@ package aws
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -30,6 +33,165 @@ func TestAccAWSEcrLifecyclePolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSEcrLifecyclePolicy_rule(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-lifecycle-%s", acctest.RandString(10))
+	resourceName := "aws_ecr_lifecycle_policy.foo"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEcrLifecyclePolicyConfigRule(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcrLifecyclePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.selection.0.tag_prefix_list.0", "v"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"countType":"imageCountMoreThan"`)),
+				),
+			},
+			{
+				Config:      testAccEcrLifecyclePolicyConfigRuleInvalid(rName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`rule priority 1 is not unique`),
+			},
+		},
+	})
+}
+
+func TestAccAWSEcrLifecyclePolicy_ruleInvalid(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-lifecycle-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcrLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEcrLifecyclePolicyConfigRuleInvalid(rName),
+				ExpectError: regexp.MustCompile(`rule priority 1 is not unique`),
+			},
+		},
+	})
+}
+
+func TestValidateEcrLifecyclePolicyRules(t *testing.T) {
+	rule := func(priority int, tagStatus string, tagPrefixes []interface{}, countType, countUnit string) interface{} {
+		return map[string]interface{}{
+			"priority":    priority,
+			"description": "",
+			"selection": []interface{}{
+				map[string]interface{}{
+					"tag_status":      tagStatus,
+					"tag_prefix_list": tagPrefixes,
+					"count_type":      countType,
+					"count_unit":      countUnit,
+					"count_number":    10,
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		Name          string
+		Rules         []interface{}
+		ExpectedError string
+	}{
+		{
+			Name: "valid",
+			Rules: []interface{}{
+				rule(1, "tagged", []interface{}{"v"}, "imageCountMoreThan", ""),
+				rule(2, "untagged", []interface{}{}, "sinceImagePushed", "days"),
+				rule(3, "any", []interface{}{}, "imageCountMoreThan", ""),
+			},
+		},
+		{
+			Name: "duplicate priority",
+			Rules: []interface{}{
+				rule(1, "untagged", []interface{}{}, "imageCountMoreThan", ""),
+				rule(1, "tagged", []interface{}{"v"}, "imageCountMoreThan", ""),
+			},
+			ExpectedError: "priority 1 is not unique",
+		},
+		{
+			Name:          "tagged without prefixes",
+			Rules:         []interface{}{rule(1, "tagged", []interface{}{}, "imageCountMoreThan", "")},
+			ExpectedError: "tag_prefix_list is required",
+		},
+		{
+			Name:          "untagged with prefixes",
+			Rules:         []interface{}{rule(1, "untagged", []interface{}{"v"}, "imageCountMoreThan", "")},
+			ExpectedError: "tag_prefix_list can only be set",
+		},
+		{
+			Name:          "since image pushed without unit",
+			Rules:         []interface{}{rule(1, "untagged", []interface{}{}, "sinceImagePushed", "")},
+			ExpectedError: "count_unit is required",
+		},
+		{
+			Name:          "image count with unit",
+			Rules:         []interface{}{rule(1, "untagged", []interface{}{}, "imageCountMoreThan", "days")},
+			ExpectedError: "count_unit cannot be set",
+		},
+		{
+			Name: "any not last",
+			Rules: []interface{}{
+				rule(1, "any", []interface{}{}, "imageCountMoreThan", ""),
+				rule(2, "untagged", []interface{}{}, "imageCountMoreThan", ""),
+			},
+			ExpectedError: "must have the highest priority value",
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateEcrLifecyclePolicyRules(tc.Rules)
+
+		if tc.ExpectedError == "" && err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.Name, err)
+		}
+		if tc.ExpectedError != "" && (err == nil || !strings.Contains(err.Error(), tc.ExpectedError)) {
+			t.Errorf("%s: expected error containing %q, got: %v", tc.Name, tc.ExpectedError, err)
+		}
+	}
+}
+
+func TestExpandEcrLifecyclePolicyRules(t *testing.T) {
+	rules := []interface{}{
+		map[string]interface{}{
+			"priority":    1,
+			"description": "Keep last 30 release images",
+			"selection": []interface{}{
+				map[string]interface{}{
+					"tag_status":      "tagged",
+					"tag_prefix_list": []interface{}{"v"},
+					"count_type":      "imageCountMoreThan",
+					"count_unit":      "",
+					"count_number":    30,
+				},
+			},
+		},
+	}
+
+	expected := `{"rules":[{"rulePriority":1,"description":"Keep last 30 release images","selection":{"tagStatus":"tagged","tagPrefixList":["v"],"countType":"imageCountMoreThan","countNumber":30},"action":{"type":"expire"}}]}`
+
+	policy, err := expandEcrLifecyclePolicyRules(rules)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if policy != expected {
+		t.Fatalf("got %s, expected %s", policy, expected)
+	}
+
+	flattened, err := flattenEcrLifecyclePolicyRules(policy)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(flattened, rules) {
+		t.Fatalf("got %#v, expected %#v", flattened, rules)
+	}
+}
+
 func TestAccAWSEcrLifecyclePolicy_import(t *testing.T) {
 	resourceName := "aws_ecr_lifecycle_policy.foo"
 	randString := acctest.RandString(10)
@@ -131,3 +293,72 @@ EOF
 }
 `, rName)
 }
+
+func testAccEcrLifecyclePolicyConfigRule(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "foo" {
+  name = "%s"
+}
+
+resource "aws_ecr_lifecycle_policy" "foo" {
+  repository = "${aws_ecr_repository.foo.name}"
+
+  rule {
+    priority    = 1
+    description = "Keep last 30 release images"
+
+    selection {
+      tag_status      = "tagged"
+      tag_prefix_list = ["v"]
+      count_type      = "imageCountMoreThan"
+      count_number    = 30
+    }
+  }
+
+  rule {
+    priority    = 2
+    description = "Expire untagged images older than 14 days"
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "sinceImagePushed"
+      count_unit   = "days"
+      count_number = 14
+    }
+  }
+}
+`, rName)
+}
+
+func testAccEcrLifecyclePolicyConfigRuleInvalid(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "foo" {
+  name = "%s"
+}
+
+resource "aws_ecr_lifecycle_policy" "foo" {
+  repository = "${aws_ecr_repository.foo.name}"
+
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 10
+    }
+  }
+
+  rule {
+    priority = 1
+
+    selection {
+      tag_status      = "tagged"
+      tag_prefix_list = ["v"]
+      count_type      = "imageCountMoreThan"
+      count_number    = 30
+    }
+  }
+}
+`, rName)
+}
@@ -154,9 +154,7 @@ func resourceAwsEipRead(d *schema.ResourceData, meta interface{}) error {
 		err := resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 			describeAddresses, err = ec2conn.DescribeAddresses(req)
 			if err != nil {
-				awsErr, ok := err.(awserr.Error)
-				if ok && (awsErr.Code() == "InvalidAllocationID.NotFound" ||
-					awsErr.Code() == "InvalidAddress.NotFound") {
+				if isAWSErrCode(err, "InvalidAllocationID.NotFound", "InvalidAddress.NotFound") {
 					return resource.RetryableError(err)
 				}
 
@@ -170,9 +168,7 @@ func resourceAwsEipRead(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		describeAddresses, err = ec2conn.DescribeAddresses(req)
 		if err != nil {
-			awsErr, ok := err.(awserr.Error)
-			if ok && (awsErr.Code() == "InvalidAllocationID.NotFound" ||
-				awsErr.Code() == "InvalidAddress.NotFound") {
+			if isAWSErrCode(err, "InvalidAllocationID.NotFound", "InvalidAddress.NotFound") {
 				log.Printf("[WARN] EIP not found, removing from state: %s", req)
 				d.SetId("")
 				return nil
@@ -157,6 +157,7 @@ func resourceAwsEksClusterCreate(d *schema.ResourceData, meta interface{}) error
 		Timeout: d.Timeout(schema.TimeoutCreate),
 		Refresh: refreshEksClusterStatus(conn, name),
 	}
+	logStateChangeConf("aws_eks_cluster", name, &stateConf)
 	_, err = stateConf.WaitForState()
 	if err != nil {
 		return err
@@ -313,6 +314,7 @@ func waitForDeleteEksCluster(conn *eks.EKS, clusterName string, timeout time.Dur
 		Timeout: timeout,
 		Refresh: refreshEksClusterStatus(conn, clusterName),
 	}
+	logStateChangeConf("aws_eks_cluster", clusterName, &stateConf)
 	cluster, err := stateConf.WaitForState()
 	if err != nil {
 		if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
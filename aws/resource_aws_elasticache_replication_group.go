@@ -227,7 +227,8 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(40 * time.Minute),
-			Update: schema.DefaultTimeout(40 * time.Minute),
+			// Online resharding of large clusters can take well over an hour.
+			Update: schema.DefaultTimeout(90 * time.Minute),
 		},
 	}
 }
@@ -508,7 +509,7 @@ func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta i
 			return fmt.Errorf("error modifying Elasticache Replication Group shard configuration: %s", err)
 		}
 
-		err = waitForModifyElasticacheReplicationGroup(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		err = waitForElasticacheReplicationGroupResharding(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("error waiting for Elasticache Replication Group (%s) shard reconfiguration completion: %s", d.Id(), err)
 		}
@@ -896,6 +897,53 @@ func waitForModifyElasticacheReplicationGroup(conn *elasticache.ElastiCache, rep
 	return err
 }
 
+// elasticacheReplicationGroupReshardingRefreshFunc reports the "resharding"
+// state while slots are being migrated and logs the progress of each shard.
+func elasticacheReplicationGroupReshardingRefreshFunc(conn *elasticache.ElastiCache, replicationGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeReplicationGroups(&elasticache.DescribeReplicationGroupsInput{
+			ReplicationGroupId: aws.String(replicationGroupID),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(resp.ReplicationGroups) == 0 {
+			return nil, "", fmt.Errorf("Error: no Cache Replication Groups found for id (%s)", replicationGroupID)
+		}
+
+		rg := resp.ReplicationGroups[0]
+
+		for _, nodeGroup := range rg.NodeGroups {
+			log.Printf("[DEBUG] ElastiCache Replication Group (%s) shard %s status: %s, slots: %s", replicationGroupID,
+				aws.StringValue(nodeGroup.NodeGroupId), aws.StringValue(nodeGroup.Status), aws.StringValue(nodeGroup.Slots))
+		}
+
+		if v := rg.PendingModifiedValues; v != nil && v.Resharding != nil && v.Resharding.SlotMigration != nil {
+			log.Printf("[INFO] ElastiCache Replication Group (%s) slot migration progress: %.1f%%", replicationGroupID,
+				aws.Float64Value(v.Resharding.SlotMigration.ProgressPercentage))
+			return rg, "resharding", nil
+		}
+
+		return rg, aws.StringValue(rg.Status), nil
+	}
+}
+
+func waitForElasticacheReplicationGroupResharding(conn *elasticache.ElastiCache, replicationGroupID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"modifying", "resharding", "snapshotting"},
+		Target:     []string{"available"},
+		Refresh:    elasticacheReplicationGroupReshardingRefreshFunc(conn, replicationGroupID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Elasticache Replication Group (%s) resharding to complete", replicationGroupID)
+	_, err := stateConf.WaitForState()
+	return err
+}
+
 func validateAwsElastiCacheReplicationGroupEngine(v interface{}, k string) (ws []string, errors []error) {
 	if strings.ToLower(v.(string)) != "redis" {
 		errors = append(errors, fmt.Errorf("The only acceptable Engine type when using Replication Groups is Redis"))
@@ -405,6 +405,7 @@ func TestAccAWSElasticacheReplicationGroup_ClusterMode_NumNodeGroups(t *testing.
 					testAccCheckAWSElasticacheReplicationGroupExists(resourceName, &rg),
 					resource.TestCheckResourceAttr(resourceName, "number_cache_clusters", "2"),
 					resource.TestCheckResourceAttr(resourceName, "cluster_mode.#", "1"),
+					testAccCheckAWSElasticacheReplicationGroupReshardingComplete(&rg, 1),
 					resource.TestCheckResourceAttr(resourceName, "cluster_mode.0.num_node_groups", "1"),
 					resource.TestCheckResourceAttr(resourceName, "cluster_mode.0.replicas_per_node_group", "1"),
 				),
@@ -415,6 +416,7 @@ func TestAccAWSElasticacheReplicationGroup_ClusterMode_NumNodeGroups(t *testing.
 					testAccCheckAWSElasticacheReplicationGroupExists(resourceName, &rg),
 					resource.TestCheckResourceAttr(resourceName, "number_cache_clusters", "4"),
 					resource.TestCheckResourceAttr(resourceName, "cluster_mode.#", "1"),
+					testAccCheckAWSElasticacheReplicationGroupReshardingComplete(&rg, 2),
 					resource.TestCheckResourceAttr(resourceName, "cluster_mode.0.num_node_groups", "2"),
 					resource.TestCheckResourceAttr(resourceName, "cluster_mode.0.replicas_per_node_group", "1"),
 				),
@@ -762,6 +764,24 @@ func testAccCheckAWSElasticacheReplicationGroupExists(n string, v *elasticache.R
 	}
 }
 
+func testAccCheckAWSElasticacheReplicationGroupReshardingComplete(rg *elasticache.ReplicationGroup, numNodeGroups int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if status := aws.StringValue(rg.Status); status != "available" {
+			return fmt.Errorf("expected replication group status available, got %s", status)
+		}
+
+		if v := rg.PendingModifiedValues; v != nil && v.Resharding != nil {
+			return fmt.Errorf("expected no pending resharding, got %s", v.Resharding)
+		}
+
+		if len(rg.NodeGroups) != numNodeGroups {
+			return fmt.Errorf("expected %d node groups, got %d", numNodeGroups, len(rg.NodeGroups))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSElasticacheReplicationDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
 
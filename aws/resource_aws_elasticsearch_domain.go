@@ -27,6 +27,8 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 			State: resourceAwsElasticSearchDomainImport,
 		},
 
+		CustomizeDiff: resourceAwsElasticSearchDomainCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"access_policies": {
 				Type:             schema.TypeString,
@@ -253,7 +255,6 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Default:  "1.5",
-				ForceNew: true,
 			},
 			"cognito_options": {
 				Type:             schema.TypeList,
@@ -742,11 +743,105 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 
+	if d.HasChange("elasticsearch_version") {
+		if err := resourceAwsElasticSearchDomainUpgrade(conn, d.Get("domain_name").(string), d.Get("elasticsearch_version").(string)); err != nil {
+			return err
+		}
+	}
+
 	d.Partial(false)
 
 	return resourceAwsElasticSearchDomainRead(d, meta)
 }
 
+// resourceAwsElasticSearchDomainCustomizeDiff upgrades the domain in place
+// when the new elasticsearch_version is a compatible upgrade target, and
+// recreates it otherwise.
+func resourceAwsElasticSearchDomainCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("elasticsearch_version") {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).esconn
+	domainName := diff.Get("domain_name").(string)
+	o, n := diff.GetChange("elasticsearch_version")
+
+	out, err := conn.GetCompatibleElasticsearchVersions(&elasticsearch.GetCompatibleElasticsearchVersionsInput{
+		DomainName: aws.String(domainName),
+	})
+	if isAWSErr(err, elasticsearch.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error checking ElasticSearch domain %q upgrade eligibility: %s", domainName, err)
+	}
+
+	for _, versions := range out.CompatibleElasticsearchVersions {
+		if aws.StringValue(versions.SourceVersion) != o.(string) {
+			continue
+		}
+		for _, target := range versions.TargetVersions {
+			if aws.StringValue(target) == n.(string) {
+				return nil
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] ElasticSearch domain %q can't be upgraded from %s to %s in place", domainName, o, n)
+	return diff.ForceNew("elasticsearch_version")
+}
+
+func resourceAwsElasticSearchDomainUpgrade(conn *elasticsearch.ElasticsearchService, domainName, version string) error {
+	input := &elasticsearch.UpgradeElasticsearchDomainInput{
+		DomainName:    aws.String(domainName),
+		TargetVersion: aws.String(version),
+	}
+
+	log.Printf("[DEBUG] Upgrading ElasticSearch domain: %s", input)
+	if _, err := conn.UpgradeElasticsearchDomain(input); err != nil {
+		return fmt.Errorf("error upgrading ElasticSearch domain %q to %s: %s", domainName, version, err)
+	}
+
+	// The upgrade runs a pre-upgrade check and takes a snapshot of the
+	// domain before the upgrade itself.
+	err := resource.Retry(60*time.Minute, func() *resource.RetryError {
+		out, err := conn.GetUpgradeStatus(&elasticsearch.GetUpgradeStatusInput{
+			DomainName: aws.String(domainName),
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		step := aws.StringValue(out.UpgradeStep)
+		status := aws.StringValue(out.StepStatus)
+		log.Printf("[INFO] ElasticSearch domain %q upgrade to %s: step %s is %s", domainName, version, step, status)
+
+		ds, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
+			DomainName: aws.String(domainName),
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if !aws.BoolValue(ds.DomainStatus.UpgradeProcessing) {
+			if aws.StringValue(ds.DomainStatus.ElasticsearchVersion) == version {
+				return nil
+			}
+			if status == elasticsearch.UpgradeStatusFailed {
+				return resource.NonRetryableError(fmt.Errorf("upgrade step %s failed", step))
+			}
+		}
+
+		return resource.RetryableError(
+			fmt.Errorf("%q: Timeout while waiting for the upgrade to complete", domainName))
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for ElasticSearch domain %q upgrade to %s: %s", domainName, version, err)
+	}
+
+	return nil
+}
+
 func resourceAwsElasticSearchDomainDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).esconn
 	domainName := d.Get("domain_name").(string)
@@ -217,6 +217,36 @@ func TestAccAWSElasticSearchDomain_v23(t *testing.T) {
 	})
 }
 
+func TestAccAWSElasticSearchDomain_versionUpgrade(t *testing.T) {
+	var domain1, domain2 elasticsearch.ElasticsearchDomainStatus
+	ri := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckESDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccESDomainConfigVersion(ri, "5.5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckESDomainExists("aws_elasticsearch_domain.example", &domain1),
+					resource.TestCheckResourceAttr(
+						"aws_elasticsearch_domain.example", "elasticsearch_version", "5.5"),
+				),
+			},
+			{
+				Config: testAccESDomainConfigVersion(ri, "5.6"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckESDomainExists("aws_elasticsearch_domain.example", &domain2),
+					testAccCheckESDomainNotRecreated(&domain1, &domain2),
+					resource.TestCheckResourceAttr(
+						"aws_elasticsearch_domain.example", "elasticsearch_version", "5.6"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSElasticSearchDomain_complex(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 	ri := acctest.RandInt()
@@ -643,6 +673,16 @@ func testAccLoadESTags(conf *elasticsearch.ElasticsearchDomainStatus, td *elasti
 	}
 }
 
+func testAccCheckESDomainNotRecreated(i, j *elasticsearch.ElasticsearchDomainStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.DomainId) != aws.StringValue(j.DomainId) {
+			return fmt.Errorf("ElasticSearch domain was recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckESDomainExists(n string, domain *elasticsearch.ElasticsearchDomainStatus) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -983,6 +1023,19 @@ resource "aws_elasticsearch_domain" "example" {
 `, randInt)
 }
 
+func testAccESDomainConfigVersion(randInt int, version string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "example" {
+  domain_name = "tf-test-%d"
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+  elasticsearch_version = %q
+}
+`, randInt, version)
+}
+
 func testAccESDomainConfig_vpc(randInt int) string {
 	return fmt.Sprintf(`
 data "aws_availability_zones" "available" {
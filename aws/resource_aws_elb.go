@@ -866,12 +866,9 @@ func sourceSGIdByName(conn *ec2.EC2, sg, vpcId string) (string, error) {
 	}
 	resp, err := conn.DescribeSecurityGroups(req)
 	if err != nil {
-		if ec2err, ok := err.(awserr.Error); ok {
-			if ec2err.Code() == "InvalidSecurityGroupID.NotFound" ||
-				ec2err.Code() == "InvalidGroup.NotFound" {
-				resp = nil
-				err = nil
-			}
+		if isAWSErrCode(err, "InvalidSecurityGroupID.NotFound", "InvalidGroup.NotFound") {
+			resp = nil
+			err = nil
 		}
 
 		if err != nil {
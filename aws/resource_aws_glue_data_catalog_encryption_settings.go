@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueDataCatalogEncryptionSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueDataCatalogEncryptionSettingsPut,
+		Read:   resourceAwsGlueDataCatalogEncryptionSettingsRead,
+		Update: resourceAwsGlueDataCatalogEncryptionSettingsPut,
+		Delete: resourceAwsGlueDataCatalogEncryptionSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"data_catalog_encryption_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_at_rest": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_encryption_mode": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											glue.CatalogEncryptionModeDisabled,
+											glue.CatalogEncryptionModeSseKms,
+										}, false),
+									},
+									// Rotating to a different key (or alias) is an in-place
+									// update: the Data Catalog re-encrypts new objects with it.
+									"sse_aws_kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsGlueDataCatalogEncryptionSettingsPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).accountid)
+
+	input := &glue.PutDataCatalogEncryptionSettingsInput{
+		CatalogId:                     aws.String(catalogID),
+		DataCatalogEncryptionSettings: expandGlueDataCatalogEncryptionSettings(d.Get("data_catalog_encryption_settings").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Putting Glue Data Catalog Encryption Settings: %s", input)
+	_, err := conn.PutDataCatalogEncryptionSettings(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Glue Data Catalog Encryption Settings (%s): %s", catalogID, err)
+	}
+
+	d.SetId(catalogID)
+
+	return resourceAwsGlueDataCatalogEncryptionSettingsRead(d, meta)
+}
+
+func resourceAwsGlueDataCatalogEncryptionSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	input := &glue.GetDataCatalogEncryptionSettingsInput{
+		CatalogId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading Glue Data Catalog Encryption Settings: %s", input)
+	output, err := conn.GetDataCatalogEncryptionSettings(input)
+
+	if err != nil {
+		return fmt.Errorf("error reading Glue Data Catalog Encryption Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("catalog_id", d.Id())
+
+	if err := d.Set("data_catalog_encryption_settings", flattenGlueDataCatalogEncryptionSettings(output.DataCatalogEncryptionSettings)); err != nil {
+		return fmt.Errorf("error setting data_catalog_encryption_settings: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueDataCatalogEncryptionSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	// Encryption settings always exist for a Data Catalog, so removing the
+	// resource restores the service default instead.
+	input := &glue.PutDataCatalogEncryptionSettingsInput{
+		CatalogId: aws.String(d.Id()),
+		DataCatalogEncryptionSettings: &glue.DataCatalogEncryptionSettings{
+			EncryptionAtRest: &glue.EncryptionAtRest{
+				CatalogEncryptionMode: aws.String(glue.CatalogEncryptionModeDisabled),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Disabling Glue Data Catalog Encryption Settings: %s", input)
+	_, err := conn.PutDataCatalogEncryptionSettings(input)
+
+	if err != nil {
+		return fmt.Errorf("error disabling Glue Data Catalog Encryption Settings (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGlueDataCatalogEncryptionSettings(l []interface{}) *glue.DataCatalogEncryptionSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &glue.DataCatalogEncryptionSettings{
+		EncryptionAtRest: expandGlueEncryptionAtRest(m["encryption_at_rest"].([]interface{})),
+	}
+}
+
+func expandGlueEncryptionAtRest(l []interface{}) *glue.EncryptionAtRest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	encryptionAtRest := &glue.EncryptionAtRest{
+		CatalogEncryptionMode: aws.String(m["catalog_encryption_mode"].(string)),
+	}
+
+	if v, ok := m["sse_aws_kms_key_id"].(string); ok && v != "" {
+		encryptionAtRest.SseAwsKmsKeyId = aws.String(v)
+	}
+
+	return encryptionAtRest
+}
+
+func flattenGlueDataCatalogEncryptionSettings(settings *glue.DataCatalogEncryptionSettings) []interface{} {
+	if settings == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"encryption_at_rest": flattenGlueEncryptionAtRest(settings.EncryptionAtRest),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenGlueEncryptionAtRest(encryptionAtRest *glue.EncryptionAtRest) []interface{} {
+	if encryptionAtRest == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"catalog_encryption_mode": aws.StringValue(encryptionAtRest.CatalogEncryptionMode),
+		"sse_aws_kms_key_id":      aws.StringValue(encryptionAtRest.SseAwsKmsKeyId),
+	}
+
+	return []interface{}{m}
+}
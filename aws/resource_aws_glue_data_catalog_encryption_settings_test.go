@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueDataCatalogEncryptionSettings_basic(t *testing.T) {
+	var settings glue.DataCatalogEncryptionSettings
+
+	resourceName := "aws_glue_data_catalog_encryption_settings.test"
+
+	// The Data Catalog encryption settings are a per-account/region singleton.
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueDataCatalogEncryptionSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueDataCatalogEncryptionSettingsConfig_SSEKMS("test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDataCatalogEncryptionSettingsExists(resourceName, &settings),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.0.catalog_encryption_mode", "SSE-KMS"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.0.sse_aws_kms_key_id", "aws_kms_key.test1", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueDataCatalogEncryptionSettingsConfig_SSEKMS("test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDataCatalogEncryptionSettingsExists(resourceName, &settings),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.0.catalog_encryption_mode", "SSE-KMS"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.0.sse_aws_kms_key_id", "aws_kms_key.test2", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSGlueDataCatalogEncryptionSettingsConfig_Disabled(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDataCatalogEncryptionSettingsExists(resourceName, &settings),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.0.catalog_encryption_mode", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.0.sse_aws_kms_key_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueDataCatalogEncryptionSettingsExists(resourceName string, settings *glue.DataCatalogEncryptionSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Data Catalog Encryption Settings ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		output, err := conn.GetDataCatalogEncryptionSettings(&glue.GetDataCatalogEncryptionSettingsInput{
+			CatalogId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output.DataCatalogEncryptionSettings == nil {
+			return fmt.Errorf("Glue Data Catalog Encryption Settings (%s) not found", rs.Primary.ID)
+		}
+
+		*settings = *output.DataCatalogEncryptionSettings
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueDataCatalogEncryptionSettingsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_data_catalog_encryption_settings" {
+			continue
+		}
+
+		output, err := conn.GetDataCatalogEncryptionSettings(&glue.GetDataCatalogEncryptionSettingsInput{
+			CatalogId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output.DataCatalogEncryptionSettings == nil || output.DataCatalogEncryptionSettings.EncryptionAtRest == nil {
+			continue
+		}
+
+		if mode := aws.StringValue(output.DataCatalogEncryptionSettings.EncryptionAtRest.CatalogEncryptionMode); mode != glue.CatalogEncryptionModeDisabled {
+			return fmt.Errorf("Glue Data Catalog Encryption Settings (%s) still enabled: %s", rs.Primary.ID, mode)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSGlueDataCatalogEncryptionSettingsConfig_SSEKMS(keyName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test1" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key" "test2" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_glue_data_catalog_encryption_settings" "test" {
+  data_catalog_encryption_settings {
+    encryption_at_rest {
+      catalog_encryption_mode = "SSE-KMS"
+      sse_aws_kms_key_id      = "${aws_kms_key.%s.arn}"
+    }
+  }
+}
+`, keyName)
+}
+
+func testAccAWSGlueDataCatalogEncryptionSettingsConfig_Disabled() string {
+	return `
+resource "aws_glue_data_catalog_encryption_settings" "test" {
+  data_catalog_encryption_settings {
+    encryption_at_rest {
+      catalog_encryption_mode = "DISABLED"
+    }
+  }
+}
+`
+}
@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+)
+
+func resourceAwsGlueResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueResourcePolicyPut,
+		Read:   resourceAwsGlueResourcePolicyRead,
+		Update: resourceAwsGlueResourcePolicyPut,
+		Delete: resourceAwsGlueResourcePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateIAMPolicyJson,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+		},
+	}
+}
+
+func resourceAwsGlueResourcePolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	input := &glue.PutResourcePolicyInput{
+		PolicyInJson: aws.String(d.Get("policy").(string)),
+	}
+
+	log.Printf("[DEBUG] Putting Glue Resource Policy: %s", input)
+	_, err := conn.PutResourcePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Glue Resource Policy: %s", err)
+	}
+
+	// The Data Catalog resource policy is a per-region singleton.
+	d.SetId(meta.(*AWSClient).region)
+
+	return resourceAwsGlueResourcePolicyRead(d, meta)
+}
+
+func resourceAwsGlueResourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	output, err := conn.GetResourcePolicy(&glue.GetResourcePolicyInput{})
+
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Glue Resource Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Glue Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	policy, err := structure.NormalizeJsonString(aws.StringValue(output.PolicyInJson))
+
+	if err != nil {
+		return fmt.Errorf("error normalizing Glue Resource Policy (%s) JSON: %s", d.Id(), err)
+	}
+
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceAwsGlueResourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[DEBUG] Deleting Glue Resource Policy: %s", d.Id())
+	_, err := conn.DeleteResourcePolicy(&glue.DeleteResourcePolicyInput{})
+
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Glue Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
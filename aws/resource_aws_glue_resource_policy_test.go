@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueResourcePolicy_basic(t *testing.T) {
+	resourceName := "aws_glue_resource_policy.test"
+
+	// The Data Catalog resource policy is a per-account/region singleton.
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueResourcePolicyConfig("glue:CreateTable"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueResourcePolicyExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`glue:CreateTable`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueResourcePolicyConfig("glue:UpdateTable"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueResourcePolicyExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`glue:UpdateTable`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueResourcePolicy_equivalentPolicy(t *testing.T) {
+	resourceName := "aws_glue_resource_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueResourcePolicyConfig_equivalent(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueResourcePolicyExists(resourceName),
+				),
+			},
+			{
+				Config:   testAccAWSGlueResourcePolicyConfig_equivalent(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueResourcePolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Resource Policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		output, err := conn.GetResourcePolicy(&glue.GetResourcePolicyInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if output.PolicyInJson == nil {
+			return fmt.Errorf("Glue Resource Policy (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueResourcePolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_resource_policy" {
+			continue
+		}
+
+		output, err := conn.GetResourcePolicy(&glue.GetResourcePolicyInput{})
+
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output.PolicyInJson != nil {
+			return fmt.Errorf("Glue Resource Policy (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSGlueResourcePolicyConfig(action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = [%q]
+    resources = ["arn:${data.aws_partition.current.partition}:glue:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:*"]
+
+    principals {
+      identifiers = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+      type        = "AWS"
+    }
+  }
+}
+
+resource "aws_glue_resource_policy" "test" {
+  policy = "${data.aws_iam_policy_document.test.json}"
+}
+`, action)
+}
+
+// Single-element arrays and differing key order must not produce a diff.
+func testAccAWSGlueResourcePolicyConfig_equivalent() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_glue_resource_policy" "test" {
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Resource": ["arn:${data.aws_partition.current.partition}:glue:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:*"],
+      "Principal": {
+        "AWS": ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+      },
+      "Effect": "Allow",
+      "Action": ["glue:CreateTable"]
+    }
+  ]
+}
+POLICY
+}
+`
+}
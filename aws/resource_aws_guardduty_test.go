@@ -27,17 +27,7 @@ func TestAccAWSGuardDuty(t *testing.T) {
 		},
 	}
 
-	for group, m := range testCases {
-		m := m
-		t.Run(group, func(t *testing.T) {
-			for name, tc := range m {
-				tc := tc
-				t.Run(name, func(t *testing.T) {
-					tc(t)
-				})
-			}
-		})
-	}
+	testAccRunSerialTests(t, testCases, 0)
 }
 
 func testAccAWSGuardDutyMemberFromEnv(t *testing.T) (string, string) {
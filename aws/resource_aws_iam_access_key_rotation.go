@@ -0,0 +1,357 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/encryption"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	iamAccessKeyRotationActionDeactivate = "deactivate"
+	iamAccessKeyRotationActionDelete     = "delete"
+)
+
+func resourceAwsIamAccessKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamAccessKeyRotationCreate,
+		Read:   resourceAwsIamAccessKeyRotationRead,
+		Update: resourceAwsIamAccessKeyRotationUpdate,
+		Delete: resourceAwsIamAccessKeyRotationDelete,
+
+		CustomizeDiff: resourceAwsIamAccessKeyRotationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rotation_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"grace_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "24h",
+				ValidateFunc: validateDuration,
+			},
+			"pgp_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"access_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted_secret": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"previous_access_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"previous_access_key_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamAccessKeyRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	d.SetId(d.Get("user").(string))
+
+	if err := resourceAwsIamAccessKeyRotationCreateKey(conn, d); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceAwsIamAccessKeyRotationRead(d, meta)
+}
+
+func resourceAwsIamAccessKeyRotationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	resp, err := conn.ListAccessKeys(&iam.ListAccessKeysInput{
+		UserName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		log.Printf("[WARN] IAM User (%s) not found, removing access key rotation from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading IAM access keys of user %s: %s", d.Id(), err)
+	}
+
+	statuses := make(map[string]string)
+	for _, key := range resp.AccessKeyMetadata {
+		statuses[aws.StringValue(key.AccessKeyId)] = aws.StringValue(key.Status)
+	}
+
+	if _, ok := statuses[d.Get("access_key_id").(string)]; !ok {
+		log.Printf("[WARN] IAM access key (%s) of user %s not found, removing access key rotation from state", d.Get("access_key_id"), d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("user", d.Id())
+
+	if status, ok := statuses[d.Get("previous_access_key_id").(string)]; ok {
+		d.Set("previous_access_key_status", status)
+	} else {
+		d.Set("previous_access_key_id", "")
+		d.Set("previous_access_key_status", "")
+	}
+
+	return nil
+}
+
+func resourceAwsIamAccessKeyRotationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	previousId := d.Get("previous_access_key_id").(string)
+
+	if d.HasChange("rotation_trigger") {
+		// A user can only have two access keys, so the previous key of an
+		// earlier rotation has to go first. It is deactivated before being
+		// deleted, and never while still within its grace period.
+		if previousId != "" {
+			status := d.Get("previous_access_key_status").(string)
+			if err := iamAccessKeyRotationCheckRotation(previousId, status, d.Get("rotated_at").(string), d.Get("grace_period").(string), time.Now()); err != nil {
+				return err
+			}
+
+			if status == iam.StatusTypeActive {
+				if err := resourceAwsIamAccessKeyRotationDeactivateKey(conn, d.Id(), previousId); err != nil {
+					return err
+				}
+			}
+			if err := resourceAwsIamAccessKeyRotationDeleteKey(conn, d.Id(), previousId); err != nil {
+				return err
+			}
+		}
+
+		currentId := d.Get("access_key_id").(string)
+		if err := resourceAwsIamAccessKeyRotationCreateKey(conn, d); err != nil {
+			return err
+		}
+
+		d.Set("previous_access_key_id", currentId)
+		d.Set("previous_access_key_status", iam.StatusTypeActive)
+		d.Set("rotated_at", time.Now().UTC().Format(time.RFC3339))
+
+		return resourceAwsIamAccessKeyRotationRead(d, meta)
+	}
+
+	action, err := iamAccessKeyRotationPreviousKeyAction(d.Get("previous_access_key_status").(string), d.Get("rotated_at").(string), d.Get("grace_period").(string), time.Now())
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case iamAccessKeyRotationActionDeactivate:
+		if err := resourceAwsIamAccessKeyRotationDeactivateKey(conn, d.Id(), previousId); err != nil {
+			return err
+		}
+	case iamAccessKeyRotationActionDelete:
+		if err := resourceAwsIamAccessKeyRotationDeleteKey(conn, d.Id(), previousId); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsIamAccessKeyRotationRead(d, meta)
+}
+
+func resourceAwsIamAccessKeyRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	for _, key := range []string{"previous_access_key_id", "access_key_id"} {
+		if id := d.Get(key).(string); id != "" {
+			if err := resourceAwsIamAccessKeyRotationDeleteKey(conn, d.Id(), id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsIamAccessKeyRotationCustomizeDiff marks the attributes of the
+// new access key as computed on a rotation, plans an update once the previous
+// access key is due to be deactivated or deleted, and refuses a rotation while
+// the previous access key is still in its grace period.
+func resourceAwsIamAccessKeyRotationCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	previousId := diff.Get("previous_access_key_id").(string)
+
+	if diff.HasChange("rotation_trigger") {
+		if previousId != "" {
+			if err := iamAccessKeyRotationCheckRotation(previousId, diff.Get("previous_access_key_status").(string), diff.Get("rotated_at").(string), diff.Get("grace_period").(string), time.Now()); err != nil {
+				return err
+			}
+		}
+
+		for _, k := range []string{"access_key_id", "secret", "encrypted_secret", "key_fingerprint", "previous_access_key_id", "previous_access_key_status", "rotated_at"} {
+			if err := diff.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if previousId == "" {
+		return nil
+	}
+
+	action, err := iamAccessKeyRotationPreviousKeyAction(diff.Get("previous_access_key_status").(string), diff.Get("rotated_at").(string), diff.Get("grace_period").(string), time.Now())
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case iamAccessKeyRotationActionDeactivate:
+		return diff.SetNewComputed("previous_access_key_status")
+	case iamAccessKeyRotationActionDelete:
+		if err := diff.SetNewComputed("previous_access_key_id"); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("previous_access_key_status")
+	}
+
+	return nil
+}
+
+// iamAccessKeyRotationPreviousKeyAction returns what to do with the previous
+// access key: deactivate it once the grace period after the rotation has
+// passed, then delete it on the next apply.
+func iamAccessKeyRotationPreviousKeyAction(status, rotatedAt, gracePeriod string, now time.Time) (string, error) {
+	switch status {
+	case iam.StatusTypeInactive:
+		return iamAccessKeyRotationActionDelete, nil
+	case iam.StatusTypeActive:
+		rotated, err := time.Parse(time.RFC3339, rotatedAt)
+		if err != nil {
+			return "", fmt.Errorf("Error parsing rotated_at (%s): %s", rotatedAt, err)
+		}
+		grace, err := time.ParseDuration(gracePeriod)
+		if err != nil {
+			return "", fmt.Errorf("Error parsing grace_period (%s): %s", gracePeriod, err)
+		}
+		if now.After(rotated.Add(grace)) {
+			return iamAccessKeyRotationActionDeactivate, nil
+		}
+	}
+
+	return "", nil
+}
+
+// iamAccessKeyRotationCheckRotation returns an error when the previous
+// access key is still active within the grace period, as deleting it to make
+// room for a new key could break clients that have not switched yet.
+func iamAccessKeyRotationCheckRotation(previousId, status, rotatedAt, gracePeriod string, now time.Time) error {
+	if status != iam.StatusTypeActive {
+		return nil
+	}
+
+	action, err := iamAccessKeyRotationPreviousKeyAction(status, rotatedAt, gracePeriod, now)
+	if err != nil {
+		return err
+	}
+	if action == "" {
+		return fmt.Errorf("Error rotating IAM access key: previous access key %s is still active within its grace_period (%s after %s)", previousId, gracePeriod, rotatedAt)
+	}
+
+	return nil
+}
+
+func resourceAwsIamAccessKeyRotationCreateKey(conn *iam.IAM, d *schema.ResourceData) error {
+	resp, err := conn.CreateAccessKey(&iam.CreateAccessKeyInput{
+		UserName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating access key for user %s: %s", d.Id(), err)
+	}
+
+	if resp.AccessKey == nil || resp.AccessKey.SecretAccessKey == nil {
+		return fmt.Errorf("CreateAccessKey response did not contain a Secret Access Key as expected")
+	}
+
+	d.Set("access_key_id", resp.AccessKey.AccessKeyId)
+
+	if v, ok := d.GetOk("pgp_key"); ok {
+		encryptionKey, err := encryption.RetrieveGPGKey(v.(string))
+		if err != nil {
+			return err
+		}
+		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, *resp.AccessKey.SecretAccessKey, "IAM Access Key Secret")
+		if err != nil {
+			return err
+		}
+
+		d.Set("key_fingerprint", fingerprint)
+		d.Set("encrypted_secret", encrypted)
+		d.Set("secret", "")
+	} else {
+		d.Set("key_fingerprint", "")
+		d.Set("encrypted_secret", "")
+		d.Set("secret", resp.AccessKey.SecretAccessKey)
+	}
+
+	return nil
+}
+
+func resourceAwsIamAccessKeyRotationDeactivateKey(conn *iam.IAM, user, id string) error {
+	log.Printf("[DEBUG] Deactivating previous IAM access key (%s) of user %s", id, user)
+	_, err := conn.UpdateAccessKey(&iam.UpdateAccessKeyInput{
+		AccessKeyId: aws.String(id),
+		Status:      aws.String(iam.StatusTypeInactive),
+		UserName:    aws.String(user),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deactivating IAM access key %s: %s", id, err)
+	}
+
+	return nil
+}
+
+func resourceAwsIamAccessKeyRotationDeleteKey(conn *iam.IAM, user, id string) error {
+	log.Printf("[DEBUG] Deleting IAM access key (%s) of user %s", id, user)
+	_, err := conn.DeleteAccessKey(&iam.DeleteAccessKeyInput{
+		AccessKeyId: aws.String(id),
+		UserName:    aws.String(user),
+	})
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error deleting IAM access key %s: %s", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestIamAccessKeyRotationPreviousKeyAction(t *testing.T) {
+	now := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Status      string
+		RotatedAt   string
+		GracePeriod string
+		Expected    string
+		ErrCount    int
+	}{
+		{"", "", "24h", "", 0},
+		{iam.StatusTypeActive, "2018-06-01T00:00:00Z", "24h", "", 0},
+		{iam.StatusTypeActive, "2018-05-31T00:00:00Z", "24h", iamAccessKeyRotationActionDeactivate, 0},
+		{iam.StatusTypeInactive, "2018-06-01T00:00:00Z", "24h", iamAccessKeyRotationActionDelete, 0},
+		{iam.StatusTypeActive, "yesterday", "24h", "", 1},
+	}
+
+	for _, tc := range cases {
+		action, err := iamAccessKeyRotationPreviousKeyAction(tc.Status, tc.RotatedAt, tc.GracePeriod, now)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("unexpected error for %#v: %s", tc, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected error for %#v", tc)
+		}
+		if action != tc.Expected {
+			t.Fatalf("expected action %q for %#v, got %q", tc.Expected, tc, action)
+		}
+	}
+}
+
+func TestIamAccessKeyRotationCheckRotation(t *testing.T) {
+	now := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Status    string
+		RotatedAt string
+		ErrCount  int
+	}{
+		{iam.StatusTypeActive, "2018-06-01T00:00:00Z", 1},
+		{iam.StatusTypeActive, "2018-05-31T00:00:00Z", 0},
+		{iam.StatusTypeInactive, "2018-06-01T00:00:00Z", 0},
+		{"", "", 0},
+	}
+
+	for _, tc := range cases {
+		err := iamAccessKeyRotationCheckRotation("AKIAEXAMPLE", tc.Status, tc.RotatedAt, "24h", now)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("unexpected error for %#v: %s", tc, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected error for %#v", tc)
+		}
+	}
+}
+
+func TestAccAWSIAMAccessKeyRotation_basic(t *testing.T) {
+	resourceName := "aws_iam_access_key_rotation.test"
+	parameterResourceName := "aws_ssm_parameter.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	var firstKeyId string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMAccessKeyRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMAccessKeyRotationConfig(rName, "1", "24h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMAccessKeyRotationKeyCount(resourceName, 1),
+					resource.TestCheckResourceAttrSet(resourceName, "access_key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret"),
+					resource.TestCheckResourceAttr(resourceName, "previous_access_key_id", ""),
+					resource.TestCheckResourceAttrPair(parameterResourceName, "value", resourceName, "access_key_id"),
+					func(s *terraform.State) error {
+						firstKeyId = s.RootModule().Resources[resourceName].Primary.Attributes["access_key_id"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccAWSIAMAccessKeyRotationConfig(rName, "2", "24h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMAccessKeyRotationKeyCount(resourceName, 2),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+						if attrs["previous_access_key_id"] != firstKeyId || attrs["access_key_id"] == firstKeyId {
+							return fmt.Errorf("expected %s to be rotated, got %#v", firstKeyId, attrs)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(resourceName, "previous_access_key_status", iam.StatusTypeActive),
+					resource.TestCheckResourceAttrSet(resourceName, "rotated_at"),
+					// The dependent parameter is planned against the new key.
+					resource.TestCheckResourceAttrPair(parameterResourceName, "value", resourceName, "access_key_id"),
+				),
+			},
+			{
+				Config:      testAccAWSIAMAccessKeyRotationConfig(rName, "3", "24h"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`still active within its grace_period`),
+			},
+			{
+				// The grace period has passed, so the previous key is deactivated
+				// and the next plan deletes it.
+				Config: testAccAWSIAMAccessKeyRotationConfig(rName, "2", "1s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "previous_access_key_status", iam.StatusTypeInactive),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSIAMAccessKeyRotationConfig(rName, "2", "1s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMAccessKeyRotationKeyCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "previous_access_key_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIAMAccessKeyRotationKeyCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iamconn
+		resp, err := conn.ListAccessKeys(&iam.ListAccessKeysInput{
+			UserName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.AccessKeyMetadata) != count {
+			return fmt.Errorf("expected %d access keys for user %s, got %d", count, rs.Primary.ID, len(resp.AccessKeyMetadata))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIAMAccessKeyRotationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_access_key_rotation" {
+			continue
+		}
+
+		resp, err := conn.ListAccessKeys(&iam.ListAccessKeysInput{
+			UserName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(resp.AccessKeyMetadata) > 0 {
+			return fmt.Errorf("IAM user %s still has access keys", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSIAMAccessKeyRotationConfig(rName, trigger, gracePeriod string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %q
+}
+
+resource "aws_iam_access_key_rotation" "test" {
+  user             = "${aws_iam_user.test.name}"
+  rotation_trigger = %q
+  grace_period     = %q
+}
+
+resource "aws_ssm_parameter" "test" {
+  name  = %q
+  type  = "String"
+  value = "${aws_iam_access_key_rotation.test.access_key_id}"
+}
+`, rName, trigger, gracePeriod, rName)
+}
@@ -21,17 +21,7 @@ func TestAccAWSIAMAccountAlias(t *testing.T) {
 		},
 	}
 
-	for group, m := range testCases {
-		m := m
-		t.Run(group, func(t *testing.T) {
-			for name, tc := range m {
-				tc := tc
-				t.Run(name, func(t *testing.T) {
-					tc(t)
-				})
-			}
-		})
-	}
+	testAccRunSerialTests(t, testCases, 0)
 }
 
 func testAccAWSIAMAccountAlias_importBasic(t *testing.T) {
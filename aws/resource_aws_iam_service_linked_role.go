@@ -20,7 +20,14 @@ func resourceAwsIamServiceLinkedRole() *schema.Resource {
 		Update: resourceAwsIamServiceLinkedRoleUpdate,
 		Delete: resourceAwsIamServiceLinkedRoleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("adopt_existing", false)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +80,12 @@ func resourceAwsIamServiceLinkedRole() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -96,6 +109,30 @@ func resourceAwsIamServiceLinkedRoleCreate(d *schema.ResourceData, meta interfac
 
 	resp, err := conn.CreateServiceLinkedRole(params)
 
+	// Some services create their service-linked role on first use, in which
+	// case creation fails as the role name "has been taken in this account".
+	if isAWSErr(err, iam.ErrCodeInvalidInputException, "has been taken in this account") && d.Get("adopt_existing").(bool) {
+		customSuffix := d.Get("custom_suffix").(string)
+
+		role, findErr := findIamServiceLinkedRole(conn, serviceName, customSuffix)
+		if findErr != nil {
+			return fmt.Errorf("Error finding existing service-linked role for %s: %s", serviceName, findErr)
+		}
+
+		if role == nil {
+			return fmt.Errorf("Error creating service-linked role with name %s: %s", serviceName, err)
+		}
+
+		log.Printf("[INFO] Adopting existing service-linked role %s", aws.StringValue(role.Arn))
+		d.SetId(aws.StringValue(role.Arn))
+
+		if aws.StringValue(role.Description) != d.Get("description").(string) {
+			return resourceAwsIamServiceLinkedRoleUpdate(d, meta)
+		}
+
+		return resourceAwsIamServiceLinkedRoleRead(d, meta)
+	}
+
 	if err != nil {
 		return fmt.Errorf("Error creating service-linked role with name %s: %s", serviceName, err)
 	}
@@ -179,9 +216,10 @@ func resourceAwsIamServiceLinkedRoleDelete(d *schema.ResourceData, meta interfac
 		return nil
 	}
 
-	err = deleteIamServiceLinkedRoleWaiter(conn, deletionID)
+	log.Printf("[DEBUG] Waiting for service-linked role %s deletion task %s", d.Id(), deletionID)
+	err = deleteIamServiceLinkedRoleWaiterWithTimeout(conn, deletionID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return fmt.Errorf("Error waiting for role (%s) to be deleted: %s", d.Id(), err)
+		return fmt.Errorf("Error waiting for role (%s) to be deleted (deletion task %s): %s", d.Id(), deletionID, err)
 	}
 
 	return nil
@@ -209,6 +247,40 @@ func decodeIamServiceLinkedRoleID(id string) (serviceName, roleName, customSuffi
 	return
 }
 
+// findIamServiceLinkedRole returns the service-linked role for the given
+// service and custom suffix, or nil if none exists.
+func findIamServiceLinkedRole(conn *iam.IAM, serviceName, customSuffix string) (*iam.Role, error) {
+	input := &iam.ListRolesInput{
+		PathPrefix: aws.String(fmt.Sprintf("/aws-service-role/%s/", serviceName)),
+	}
+
+	var result *iam.Role
+	err := conn.ListRolesPages(input, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		for _, role := range page.Roles {
+			if iamServiceLinkedRoleNameHasSuffix(aws.StringValue(role.RoleName), customSuffix) {
+				result = role
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func iamServiceLinkedRoleNameHasSuffix(roleName, customSuffix string) bool {
+	roleNameParts := strings.Split(roleName, "_")
+
+	switch len(roleNameParts) {
+	case 1:
+		return customSuffix == ""
+	case 2:
+		return roleNameParts[1] == customSuffix
+	default:
+		return false
+	}
+}
+
 func deleteIamServiceLinkedRole(conn *iam.IAM, roleName string) (string, error) {
 	params := &iam.DeleteServiceLinkedRoleInput{
 		RoleName: aws.String(roleName),
@@ -227,11 +299,15 @@ func deleteIamServiceLinkedRole(conn *iam.IAM, roleName string) (string, error)
 }
 
 func deleteIamServiceLinkedRoleWaiter(conn *iam.IAM, deletionTaskID string) error {
+	return deleteIamServiceLinkedRoleWaiterWithTimeout(conn, deletionTaskID, 5*time.Minute)
+}
+
+func deleteIamServiceLinkedRoleWaiterWithTimeout(conn *iam.IAM, deletionTaskID string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{iam.DeletionTaskStatusTypeInProgress, iam.DeletionTaskStatusTypeNotStarted},
 		Target:  []string{iam.DeletionTaskStatusTypeSucceeded},
 		Refresh: deleteIamServiceLinkedRoleRefreshFunc(conn, deletionTaskID),
-		Timeout: 5 * time.Minute,
+		Timeout: timeout,
 		Delay:   10 * time.Second,
 	}
 
@@ -257,6 +333,30 @@ func deleteIamServiceLinkedRoleRefreshFunc(conn *iam.IAM, deletionTaskId string)
 			return nil, "", err
 		}
 
-		return resp, aws.StringValue(resp.Status), nil
+		status := aws.StringValue(resp.Status)
+		if status == iam.DeletionTaskStatusTypeFailed {
+			return resp, status, fmt.Errorf("deletion task %s failed: %s", deletionTaskId, flattenIamServiceLinkedRoleDeletionFailureReason(resp.Reason))
+		}
+
+		return resp, status, nil
+	}
+}
+
+func flattenIamServiceLinkedRoleDeletionFailureReason(reason *iam.DeletionTaskFailureReasonType) string {
+	if reason == nil {
+		return "unknown reason"
+	}
+
+	message := aws.StringValue(reason.Reason)
+
+	var usages []string
+	for _, usage := range reason.RoleUsageList {
+		usages = append(usages, fmt.Sprintf("%s: %s", aws.StringValue(usage.Region), strings.Join(aws.StringValueSlice(usage.Resources), ", ")))
 	}
+
+	if len(usages) > 0 {
+		message = fmt.Sprintf("%s (role in use by %s)", message, strings.Join(usages, "; "))
+	}
+
+	return message
 }
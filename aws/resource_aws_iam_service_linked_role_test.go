@@ -241,6 +241,81 @@ func TestAccAWSIAMServiceLinkedRole_Description(t *testing.T) {
 	})
 }
 
+func TestIamServiceLinkedRoleNameHasSuffix(t *testing.T) {
+	var testCases = []struct {
+		RoleName     string
+		CustomSuffix string
+		Expected     bool
+	}{
+		{
+			RoleName:     "AWSServiceRoleForAutoScaling",
+			CustomSuffix: "",
+			Expected:     true,
+		},
+		{
+			RoleName:     "AWSServiceRoleForAutoScaling",
+			CustomSuffix: "custom-suffix",
+			Expected:     false,
+		},
+		{
+			RoleName:     "AWSServiceRoleForAutoScaling_custom-suffix",
+			CustomSuffix: "",
+			Expected:     false,
+		},
+		{
+			RoleName:     "AWSServiceRoleForAutoScaling_custom-suffix",
+			CustomSuffix: "custom-suffix",
+			Expected:     true,
+		},
+		{
+			RoleName:     "AWSServiceRoleForAutoScaling_other-suffix",
+			CustomSuffix: "custom-suffix",
+			Expected:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := iamServiceLinkedRoleNameHasSuffix(tc.RoleName, tc.CustomSuffix); got != tc.Expected {
+			t.Fatalf("expected %q with suffix %q to return %t, received: %t", tc.RoleName, tc.CustomSuffix, tc.Expected, got)
+		}
+	}
+}
+
+func TestAccAWSIAMServiceLinkedRole_AdoptExisting(t *testing.T) {
+	resourceName := "aws_iam_service_linked_role.test"
+	awsServiceName := "autoscaling.amazonaws.com"
+	name := "AWSServiceRoleForAutoScaling_"
+	customSuffix := acctest.RandomWithPrefix("tf-acc-test")
+	name += customSuffix
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMServiceLinkedRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+					_, err := conn.CreateServiceLinkedRole(&iam.CreateServiceLinkedRoleInput{
+						AWSServiceName: aws.String(awsServiceName),
+						CustomSuffix:   aws.String(customSuffix),
+					})
+					if err != nil {
+						t.Fatalf("error creating service-linked role: %s", err)
+					}
+				},
+				Config: testAccAWSIAMServiceLinkedRoleConfig_AdoptExisting(awsServiceName, customSuffix, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMServiceLinkedRoleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSIAMServiceLinkedRoleDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).iamconn
 
@@ -329,3 +404,14 @@ resource "aws_iam_service_linked_role" "test" {
 }
 `, awsServiceName, customSuffix, description)
 }
+
+func testAccAWSIAMServiceLinkedRoleConfig_AdoptExisting(awsServiceName, customSuffix, description string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_service_linked_role" "test" {
+  aws_service_name = "%s"
+  custom_suffix    = "%s"
+  description      = "%s"
+  adopt_existing   = true
+}
+`, awsServiceName, customSuffix, description)
+}
@@ -1,12 +1,16 @@
 package aws
 
 import (
+	"crypto/md5"
+	"crypto/x509"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"golang.org/x/crypto/ssh"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -59,6 +63,14 @@ func resourceAwsKeyPair() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"fingerprint_md5": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fingerprint_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -110,6 +122,23 @@ func resourceAwsKeyPairRead(d *schema.ResourceData, meta interface{}) error {
 		if *keyPair.KeyName == d.Id() {
 			d.Set("key_name", keyPair.KeyName)
 			d.Set("fingerprint", keyPair.KeyFingerprint)
+
+			// Fingerprints can only be derived from OpenSSH formatted keys
+			// and are not available after import.
+			pubKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(d.Get("public_key").(string)))
+			if err != nil {
+				log.Printf("[DEBUG] Unable to parse public key of Key Pair (%s): %s", d.Id(), err)
+				return nil
+			}
+
+			d.Set("fingerprint_md5", ssh.FingerprintLegacyMD5(pubKey))
+			d.Set("fingerprint_sha256", ssh.FingerprintSHA256(pubKey))
+
+			if !keyPairFingerprintMatches(pubKey, aws.StringValue(keyPair.KeyFingerprint)) {
+				log.Printf("[WARN] Key Pair (%s) key material was replaced outside of Terraform", d.Id())
+				d.Set("public_key", "")
+			}
+
 			return nil
 		}
 	}
@@ -125,3 +154,30 @@ func resourceAwsKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
 	})
 	return err
 }
+
+// keyPairFingerprintMatches reports whether an EC2 fingerprint belongs to the
+// public key. EC2 fingerprints imported RSA keys with the MD5 digest of the
+// DER encoded key, and other keys with the same SHA256 digest as OpenSSH.
+func keyPairFingerprintMatches(pubKey ssh.PublicKey, fingerprint string) bool {
+	if strings.TrimRight(strings.TrimPrefix(fingerprint, "SHA256:"), "=") == strings.TrimPrefix(ssh.FingerprintSHA256(pubKey), "SHA256:") {
+		return true
+	}
+
+	cryptoKey, ok := pubKey.(ssh.CryptoPublicKey)
+	if !ok {
+		return false
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(cryptoKey.CryptoPublicKey())
+	if err != nil {
+		return false
+	}
+
+	sum := md5.Sum(der)
+	hexBytes := make([]string, len(sum))
+	for i, b := range sum {
+		hexBytes[i] = fmt.Sprintf("%02x", b)
+	}
+
+	return fingerprint == strings.Join(hexBytes, ":")
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"golang.org/x/crypto/ssh"
 )
 
 func init() {
@@ -75,6 +76,8 @@ func TestAccAWSKeyPair_basic(t *testing.T) {
 					testAccCheckAWSKeyPairExists(resourceName, &keyPair),
 					testAccCheckAWSKeyPairFingerprint(&keyPair, fingerprint),
 					resource.TestCheckResourceAttr(resourceName, "fingerprint", fingerprint),
+					resource.TestCheckResourceAttr(resourceName, "fingerprint_md5", "8a:47:95:bb:b1:45:66:ef:99:f5:80:91:cc:be:94:48"),
+					resource.TestCheckResourceAttr(resourceName, "fingerprint_sha256", "SHA256:x7ejfp5QoHYbsooRA5ztdcoWgk1qOlqE7lUxSin11I0"),
 					resource.TestCheckResourceAttr(resourceName, "key_name", "tf-acc-key-pair"),
 				),
 			},
@@ -82,7 +85,7 @@ func TestAccAWSKeyPair_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"public_key"},
+				ImportStateVerifyIgnore: []string{"public_key", "fingerprint_md5", "fingerprint_sha256"},
 			},
 		},
 	})
@@ -144,6 +147,32 @@ func TestAccAWSKeyPair_namePrefix(t *testing.T) {
 	})
 }
 
+func TestKeyPairFingerprintMatches(t *testing.T) {
+	pubKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(testAccAWSKeyPairPublicKey))
+	if err != nil {
+		t.Fatalf("error parsing public key: %s", err)
+	}
+
+	cases := []struct {
+		Fingerprint string
+		Expected    bool
+	}{
+		// MD5 digest of the DER encoded key, as computed by EC2 for imported RSA keys
+		{Fingerprint: "d7:ff:a6:63:18:64:9c:57:a1:ee:ca:a4:ad:c2:81:62", Expected: true},
+		// SHA256 digest, as computed by EC2 for imported ED25519 keys
+		{Fingerprint: "x7ejfp5QoHYbsooRA5ztdcoWgk1qOlqE7lUxSin11I0=", Expected: true},
+		// OpenSSH MD5 digest, which EC2 does not use
+		{Fingerprint: "8a:47:95:bb:b1:45:66:ef:99:f5:80:91:cc:be:94:48", Expected: false},
+		{Fingerprint: "00:11:22:33:44:55:66:77:88:99:aa:bb:cc:dd:ee:ff", Expected: false},
+	}
+
+	for _, tc := range cases {
+		if got := keyPairFingerprintMatches(pubKey, tc.Fingerprint); got != tc.Expected {
+			t.Errorf("%s: got %t, expected %t", tc.Fingerprint, got, tc.Expected)
+		}
+	}
+}
+
 func testAccCheckAWSKeyPairDestroy(s *terraform.State) error {
 	ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -224,6 +253,8 @@ func testAccCheckAWSKeyPairExists(n string, res *ec2.KeyPairInfo) resource.TestC
 	}
 }
 
+const testAccAWSKeyPairPublicKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQD3F6tyPEFEzV0LX3X8BsXdMsQz1x2cEikKDEY0aIj41qgxMCP/iteneqXSIFZBp5vizPvaoIR3Um9xK7PGoW8giupGn+EPuxIA4cDM4vzOqOkiMPhz5XK0whEjkVzTo4+S0puvDZuwIsdiW9mxhJc7tgBNL0cYlWSYVkz4G/fslNfRPW5mYAM49f4fhtxPb5ok4Q2Lg9dPKVHO/Bgeu5woMc7RY0p1ej6D4CKFE6lymSDJpW0YHX/wqE9+cfEauh7xZcG0q9t2ta6F6fmX0agvpFyZo8aFbXeUBr7osSCJNgvavWbM/06niWrOvYX2xwWdhXmXSrbX8ZbabVohBK41 phodgson@thoughtworks.com"
+
 const testAccAWSKeyPairConfig = `
 resource "aws_key_pair" "a_key_pair" {
   key_name   = "tf-acc-key-pair"
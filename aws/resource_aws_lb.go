@@ -155,6 +155,12 @@ func resourceAwsLb() *schema.Resource {
 				Default:  false,
 			},
 
+			"enable_zonal_shift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"idle_timeout": {
 				Type:             schema.TypeInt,
 				Optional:         true,
@@ -398,6 +404,14 @@ func resourceAwsLbUpdate(d *schema.ResourceData, meta interface{}) error {
 		})
 	}
 
+	// Only sent when changed, as not all regions know the attribute.
+	if d.HasChange("enable_zonal_shift") {
+		attributes = append(attributes, &elbv2.LoadBalancerAttribute{
+			Key:   aws.String("zonal_shift.config.enabled"),
+			Value: aws.String(strconv.FormatBool(d.Get("enable_zonal_shift").(bool))),
+		})
+	}
+
 	if len(attributes) != 0 {
 		input := &elbv2.ModifyLoadBalancerAttributesInput{
 			LoadBalancerArn: aws.String(d.Id()),
@@ -724,6 +738,10 @@ func flattenAwsLbResource(d *schema.ResourceData, meta interface{}, lb *elbv2.Lo
 			protectionEnabled := aws.StringValue(attr.Value) == "true"
 			log.Printf("[DEBUG] Setting LB Deletion Protection Enabled: %t", protectionEnabled)
 			d.Set("enable_deletion_protection", protectionEnabled)
+		case "zonal_shift.config.enabled":
+			zonalShiftEnabled := aws.StringValue(attr.Value) == "true"
+			log.Printf("[DEBUG] Setting LB Zonal Shift Enabled: %t", zonalShiftEnabled)
+			d.Set("enable_zonal_shift", zonalShiftEnabled)
 		case "routing.http2.enabled":
 			http2Enabled := aws.StringValue(attr.Value) == "true"
 			log.Printf("[DEBUG] Setting ALB HTTP/2 Enabled: %t", http2Enabled)
@@ -369,39 +369,28 @@ func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.Get("apply_immediately").(bool) {
+	if mqBrokerRequiresReboot(d) && d.Get("apply_immediately").(bool) {
+		log.Printf("[INFO] Rebooting MQ Broker (%s) to apply changes", d.Id())
 		_, err := conn.RebootBroker(&mq.RebootBrokerInput{
 			BrokerId: aws.String(d.Id()),
 		})
 		if err != nil {
-			return err
+			return fmt.Errorf("error rebooting MQ Broker (%s): %s", d.Id(), err)
 		}
 
-		stateConf := resource.StateChangeConf{
-			Pending: []string{
-				mq.BrokerStateRunning,
-				mq.BrokerStateRebootInProgress,
-			},
-			Target:  []string{mq.BrokerStateRunning},
-			Timeout: 30 * time.Minute,
-			Refresh: func() (interface{}, string, error) {
-				out, err := conn.DescribeBroker(&mq.DescribeBrokerInput{
-					BrokerId: aws.String(d.Id()),
-				})
-				if err != nil {
-					return 42, "", err
-				}
-
-				return out, *out.BrokerState, nil
-			},
-		}
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return err
+		if err := waitForMqBrokerReboot(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MQ Broker (%s) reboot: %s", d.Id(), err)
 		}
 	}
 
-	return nil
+	return resourceAwsMqBrokerRead(d, meta)
+}
+
+// mqBrokerRequiresReboot returns whether there are configuration, log or
+// user changes, which are pending until the broker is rebooted, either now or
+// during the next maintenance window.
+func mqBrokerRequiresReboot(d resourceDiffer) bool {
+	return d.HasChange("configuration") || d.HasChange("logs") || d.HasChange("user")
 }
 
 func resourceAwsMqBrokerDelete(d *schema.ResourceData, meta interface{}) error {
@@ -438,6 +427,32 @@ func resourceAwsMqUserHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
+func waitForMqBrokerReboot(conn *mq.MQ, id string) error {
+	stateConf := resource.StateChangeConf{
+		Pending: []string{
+			mq.BrokerStateRebootInProgress,
+		},
+		Target:  []string{mq.BrokerStateRunning},
+		Timeout: 30 * time.Minute,
+		// The broker can briefly report RUNNING after the reboot request.
+		Delay:                     30 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 3,
+		Refresh: func() (interface{}, string, error) {
+			out, err := conn.DescribeBroker(&mq.DescribeBrokerInput{
+				BrokerId: aws.String(id),
+			})
+			if err != nil {
+				return 42, "", err
+			}
+
+			return out, *out.BrokerState, nil
+		},
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
 func waitForMqBrokerDeletion(conn *mq.MQ, id string) error {
 	stateConf := resource.StateChangeConf{
 		Pending: []string{
@@ -237,6 +237,85 @@ func TestDiffAwsMqBrokerUsers(t *testing.T) {
 	}
 }
 
+type testMqBrokerDiffer map[string]bool
+
+func (d testMqBrokerDiffer) HasChange(key string) bool {
+	return d[key]
+}
+
+func TestMqBrokerRequiresReboot(t *testing.T) {
+	cases := []struct {
+		Changes  testMqBrokerDiffer
+		Expected bool
+	}{
+		{testMqBrokerDiffer{}, false},
+		{testMqBrokerDiffer{"apply_immediately": true}, false},
+		{testMqBrokerDiffer{"configuration": true}, true},
+		{testMqBrokerDiffer{"logs": true}, true},
+		{testMqBrokerDiffer{"user": true}, true},
+	}
+
+	for _, tc := range cases {
+		if actual := mqBrokerRequiresReboot(tc.Changes); actual != tc.Expected {
+			t.Fatalf("expected %t for changes %v, got %t", tc.Expected, tc.Changes, actual)
+		}
+	}
+}
+
+func TestAccAWSMqBroker_rebootOnLogsChange(t *testing.T) {
+	sgName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	brokerName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMqBrokerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMqBrokerConfig_applyImmediatelyLogs(sgName, brokerName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMqBrokerExists("aws_mq_broker.test"),
+					resource.TestCheckResourceAttr("aws_mq_broker.test", "logs.0.audit", "false"),
+				),
+			},
+			{
+				// Log changes are only applied by a reboot.
+				Config: testAccMqBrokerConfig_applyImmediatelyLogs(sgName, brokerName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMqBrokerRebooted("aws_mq_broker.test"),
+					resource.TestCheckResourceAttr("aws_mq_broker.test", "logs.0.audit", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMqBrokerRebooted(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).mqconn
+		out, err := conn.DescribeBroker(&mq.DescribeBrokerInput{
+			BrokerId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if state := aws.StringValue(out.BrokerState); state != mq.BrokerStateRunning {
+			return fmt.Errorf("expected MQ Broker %s to be %s, got %s", rs.Primary.ID, mq.BrokerStateRunning, state)
+		}
+		if out.Logs != nil && out.Logs.Pending != nil {
+			return fmt.Errorf("expected no pending log changes for MQ Broker %s, got %s", rs.Primary.ID, out.Logs.Pending)
+		}
+
+		return nil
+	}
+}
+
 func TestAccAWSMqBroker_basic(t *testing.T) {
 	sgName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	brokerName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
@@ -623,6 +702,30 @@ resource "aws_mq_broker" "test" {
 }`, sgName, brokerName)
 }
 
+func testAccMqBrokerConfig_applyImmediatelyLogs(sgName, brokerName string, audit bool) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = "%s"
+}
+
+resource "aws_mq_broker" "test" {
+  apply_immediately = true
+  broker_name = "%s"
+  engine_type = "ActiveMQ"
+  engine_version = "5.15.0"
+  host_instance_type = "mq.t2.micro"
+  security_groups = ["${aws_security_group.test.id}"]
+  logs {
+    general = true
+    audit = %t
+  }
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}`, sgName, brokerName, audit)
+}
+
 func testAccMqBrokerConfig_allFieldsDefaultVpc(sgName, cfgName, cfgBody, brokerName string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "mq1" {
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -47,7 +48,69 @@ func resourceAwsNetworkInterfaceAttachment() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: resourceAwsNetworkInterfaceAttachmentCustomizeDiff,
+	}
+}
+
+// resourceAwsNetworkInterfaceAttachmentCustomizeDiff reports a device index
+// that is already in use on the instance at plan time, rather than as an
+// opaque AttachNetworkInterface failure during apply.
+func resourceAwsNetworkInterfaceAttachmentCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// Only new attachments are checked, a replaced attachment frees its
+	// own device index before the new one is created.
+	if diff.Id() != "" {
+		return nil
+	}
+
+	if !diff.NewValueKnown("instance_id") || !diff.NewValueKnown("device_index") {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).ec2conn
+
+	instanceId := diff.Get("instance_id").(string)
+	deviceIndex := diff.Get("device_index").(int)
+
+	eni, err := findNetworkInterfaceByInstanceDeviceIndex(conn, instanceId, deviceIndex)
+	if err != nil {
+		return fmt.Errorf("error checking device index %d of instance (%s): %s", deviceIndex, instanceId, err)
+	}
+
+	if eni == nil {
+		return nil
+	}
+
+	// A network interface that is not created yet can't be the one already
+	// attached at the device index.
+	if diff.NewValueKnown("network_interface_id") && aws.StringValue(eni.NetworkInterfaceId) == diff.Get("network_interface_id").(string) {
+		return nil
+	}
+
+	return fmt.Errorf("device index %d of instance (%s) is already in use by network interface (%s)",
+		deviceIndex, instanceId, aws.StringValue(eni.NetworkInterfaceId))
+}
+
+// findNetworkInterfaceByInstanceDeviceIndex returns the network interface
+// attached to the instance at the given device index, or nil if there is none.
+func findNetworkInterfaceByInstanceDeviceIndex(conn *ec2.EC2, instanceId string, deviceIndex int) (*ec2.NetworkInterface, error) {
+	resp, err := conn.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"attachment.instance-id":  instanceId,
+			"attachment.device-index": strconv.Itoa(deviceIndex),
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, eni := range resp.NetworkInterfaces {
+		if eni.Attachment != nil && aws.StringValue(eni.Attachment.Status) != ec2.AttachmentStatusDetached {
+			return eni, nil
+		}
 	}
+
+	return nil, nil
 }
 
 func resourceAwsNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
@@ -73,10 +136,11 @@ func resourceAwsNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta in
 		return err
 	}
 
+	log.Printf("[DEBUG] Waiting for ENI (%s) attachment (%s) to become attached", network_interface_id, aws.StringValue(resp.AttachmentId))
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"false"},
-		Target:     []string{"true"},
-		Refresh:    networkInterfaceAttachmentRefreshFunc(conn, network_interface_id),
+		Pending:    []string{ec2.AttachmentStatusAttaching},
+		Target:     []string{ec2.AttachmentStatusAttached},
+		Refresh:    networkInterfaceAttachmentStatusRefreshFunc(conn, network_interface_id, aws.StringValue(resp.AttachmentId)),
 		Timeout:    5 * time.Minute,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -85,7 +149,7 @@ func resourceAwsNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta in
 	_, err = stateConf.WaitForState()
 	if err != nil {
 		return fmt.Errorf(
-			"Error waiting for Volume (%s) to attach to Instance: %s, error: %s", network_interface_id, instance_id, err)
+			"Error waiting for ENI (%s) to attach to Instance: %s, error: %s", network_interface_id, instance_id, err)
 	}
 
 	d.SetId(*resp.AttachmentId)
@@ -151,9 +215,9 @@ func resourceAwsNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta in
 
 	log.Printf("[DEBUG] Waiting for ENI (%s) to become detached", interfaceId)
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"true"},
-		Target:  []string{"false"},
-		Refresh: networkInterfaceAttachmentRefreshFunc(conn, interfaceId),
+		Pending: []string{ec2.AttachmentStatusAttaching, ec2.AttachmentStatusAttached, ec2.AttachmentStatusDetaching},
+		Target:  []string{ec2.AttachmentStatusDetached},
+		Refresh: networkInterfaceDetachmentStatusRefreshFunc(conn, interfaceId, d.Id()),
 		Timeout: 10 * time.Minute,
 	}
 
@@ -164,3 +228,48 @@ func resourceAwsNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta in
 
 	return nil
 }
+
+// networkInterfaceAttachmentStatusRefreshFunc returns the status of the given
+// attachment of the network interface. A missing interface or attachment is
+// reported as not found, as it may not be visible yet right after attaching.
+func networkInterfaceAttachmentStatusRefreshFunc(conn *ec2.EC2, eniId, attachmentId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+			NetworkInterfaceIds: []*string{aws.String(eniId)},
+		})
+		if isAWSErr(err, "InvalidNetworkInterfaceID.NotFound", "") {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(resp.NetworkInterfaces) == 0 {
+			return nil, "", nil
+		}
+
+		eni := resp.NetworkInterfaces[0]
+		if eni.Attachment == nil || aws.StringValue(eni.Attachment.AttachmentId) != attachmentId {
+			return nil, "", nil
+		}
+
+		status := aws.StringValue(eni.Attachment.Status)
+		log.Printf("[DEBUG] ENI %s attachment %s has status %s", eniId, attachmentId, status)
+		return eni, status, nil
+	}
+}
+
+// networkInterfaceDetachmentStatusRefreshFunc wraps
+// networkInterfaceAttachmentStatusRefreshFunc, reporting a missing interface
+// or attachment as detached.
+func networkInterfaceDetachmentStatusRefreshFunc(conn *ec2.EC2, eniId, attachmentId string) resource.StateRefreshFunc {
+	refresh := networkInterfaceAttachmentStatusRefreshFunc(conn, eniId, attachmentId)
+
+	return func() (interface{}, string, error) {
+		eni, status, err := refresh()
+		if err == nil && eni == nil {
+			return &ec2.NetworkInterface{}, ec2.AttachmentStatusDetached, nil
+		}
+		return eni, status, err
+	}
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -31,14 +32,40 @@ func TestAccAWSNetworkInterfaceAttachment_basic(t *testing.T) {
 						"aws_network_interface_attachment.test", "network_interface_id"),
 					resource.TestCheckResourceAttrSet(
 						"aws_network_interface_attachment.test", "attachment_id"),
-					resource.TestCheckResourceAttrSet(
-						"aws_network_interface_attachment.test", "status"),
+					resource.TestCheckResourceAttr(
+						"aws_network_interface_attachment.test", "status", "attached"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccAWSNetworkInterfaceAttachment_deviceIndexConflict(t *testing.T) {
+	var conf ec2.NetworkInterface
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSENIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkInterfaceAttachmentConfig_basic(rInt),
+				Check:  testAccCheckAWSENIExists("aws_network_interface.bar", &conf),
+			},
+			{
+				Config:      testAccAWSNetworkInterfaceAttachmentConfig_deviceIndexConflict(rInt),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`device index 1 of instance \(i-[0-9a-f]+\) is already in use`),
+			},
+			{
+				Config:      testAccAWSNetworkInterfaceAttachmentConfig_deviceIndexConflict(rInt),
+				ExpectError: regexp.MustCompile(`device index 1 of instance \(i-[0-9a-f]+\) is already in use`),
+			},
+		},
+	})
+}
+
 func testAccAWSNetworkInterfaceAttachmentConfig_basic(rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "foo" {
@@ -96,3 +123,19 @@ resource "aws_network_interface_attachment" "test" {
 }
 `, rInt, rInt)
 }
+
+func testAccAWSNetworkInterfaceAttachmentConfig_deviceIndexConflict(rInt int) string {
+	return testAccAWSNetworkInterfaceAttachmentConfig_basic(rInt) + `
+resource "aws_network_interface" "baz" {
+  subnet_id       = "${aws_subnet.foo.id}"
+  private_ips     = ["172.16.10.101"]
+  security_groups = ["${aws_security_group.foo.id}"]
+}
+
+resource "aws_network_interface_attachment" "conflict" {
+  device_index         = 1
+  instance_id          = "${aws_instance.foo.id}"
+  network_interface_id = "${aws_network_interface.baz.id}"
+}
+`
+}
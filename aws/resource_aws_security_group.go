@@ -793,12 +793,9 @@ func SGStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 		}
 		resp, err := conn.DescribeSecurityGroups(req)
 		if err != nil {
-			if ec2err, ok := err.(awserr.Error); ok {
-				if ec2err.Code() == "InvalidSecurityGroupID.NotFound" ||
-					ec2err.Code() == "InvalidGroup.NotFound" {
-					resp = nil
-					err = nil
-				}
+			if isAWSErrCode(err, "InvalidSecurityGroupID.NotFound", "InvalidGroup.NotFound") {
+				resp = nil
+				err = nil
 			}
 
 			if err != nil {
@@ -0,0 +1,346 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+var serverlessApplicationRepositorySemanticVersionRegexp = regexp.MustCompile(`^(\d+)\.(\d+)\.(\d+)$`)
+
+const (
+	serverlessApplicationRepositoryInitialSemanticVersion = "1.0.0"
+
+	serverlessApplicationRepositoryIncrementMajor = "major"
+	serverlessApplicationRepositoryIncrementMinor = "minor"
+	serverlessApplicationRepositoryIncrementPatch = "patch"
+)
+
+func resourceAwsServerlessApplicationRepositoryApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServerlessApplicationRepositoryApplicationCreate,
+		Read:   resourceAwsServerlessApplicationRepositoryApplicationRead,
+		Update: resourceAwsServerlessApplicationRepositoryApplicationUpdate,
+		Delete: resourceAwsServerlessApplicationRepositoryApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsServerlessApplicationRepositoryApplicationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"author": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"home_page_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"license_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"license_url"},
+			},
+			"license_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"license_body"},
+			},
+			"readme_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"readme_url"},
+			},
+			"readme_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"readme_body"},
+			},
+			"spdx_license_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"semantic_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringMatch(serverlessApplicationRepositorySemanticVersionRegexp,
+					"must be a semantic version, e.g. 1.0.0"),
+				ConflictsWith: []string{"semantic_version_auto_increment"},
+			},
+			"semantic_version_auto_increment": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					serverlessApplicationRepositoryIncrementMajor,
+					serverlessApplicationRepositoryIncrementMinor,
+					serverlessApplicationRepositoryIncrementPatch,
+				}, false),
+				ConflictsWith: []string{"semantic_version"},
+			},
+			"source_code_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"template_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_url"},
+			},
+			"template_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_body"},
+			},
+		},
+	}
+}
+
+func resourceAwsServerlessApplicationRepositoryApplicationCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	increment := diff.Get("semantic_version_auto_increment").(string)
+
+	if diff.Id() == "" {
+		if increment != "" && diff.Get("semantic_version").(string) == "" {
+			return diff.SetNew("semantic_version", serverlessApplicationRepositoryInitialSemanticVersion)
+		}
+		return nil
+	}
+
+	// A changed template can only be published as a new application version.
+	if !diff.HasChange("template_body") && !diff.HasChange("template_url") && !diff.HasChange("source_code_url") {
+		return nil
+	}
+	if diff.HasChange("semantic_version") {
+		return nil
+	}
+	if increment == "" {
+		return fmt.Errorf("semantic_version must be changed, or semantic_version_auto_increment set, to publish a changed template")
+	}
+
+	o, _ := diff.GetChange("semantic_version")
+	version, err := incrementServerlessApplicationRepositorySemanticVersion(o.(string), increment)
+	if err != nil {
+		return err
+	}
+
+	return diff.SetNew("semantic_version", version)
+}
+
+func resourceAwsServerlessApplicationRepositoryApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).serverlessrepoconn
+
+	input := &serverlessapplicationrepository.CreateApplicationRequest{
+		Author:      aws.String(d.Get("author").(string)),
+		Description: aws.String(d.Get("description").(string)),
+		Name:        aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("home_page_url"); ok {
+		input.HomePageUrl = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("labels"); ok {
+		input.Labels = expandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("license_body"); ok {
+		input.LicenseBody = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("license_url"); ok {
+		input.LicenseUrl = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("readme_body"); ok {
+		input.ReadmeBody = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("readme_url"); ok {
+		input.ReadmeUrl = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("spdx_license_id"); ok {
+		input.SpdxLicenseId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("semantic_version"); ok {
+		input.SemanticVersion = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("source_code_url"); ok {
+		input.SourceCodeUrl = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok {
+		input.TemplateBody = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateUrl = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Serverless Application Repository application: %s", input)
+	out, err := conn.CreateApplication(input)
+	if err != nil {
+		return fmt.Errorf("error creating Serverless Application Repository application: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.ApplicationId))
+
+	return resourceAwsServerlessApplicationRepositoryApplicationRead(d, meta)
+}
+
+func resourceAwsServerlessApplicationRepositoryApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).serverlessrepoconn
+
+	out, err := conn.GetApplication(&serverlessapplicationrepository.GetApplicationInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, serverlessapplicationrepository.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Serverless Application Repository application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Serverless Application Repository application (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", out.ApplicationId)
+	d.Set("name", out.Name)
+	d.Set("author", out.Author)
+	d.Set("description", out.Description)
+	d.Set("home_page_url", out.HomePageUrl)
+	d.Set("spdx_license_id", out.SpdxLicenseId)
+	if err := d.Set("labels", schema.NewSet(schema.HashString, flattenStringList(out.Labels))); err != nil {
+		return fmt.Errorf("error setting labels: %s", err)
+	}
+
+	// The license, readme and template URLs returned by the API point to
+	// copies stored by the service, so the configured values are kept.
+	if out.Version != nil {
+		d.Set("semantic_version", out.Version.SemanticVersion)
+		d.Set("source_code_url", out.Version.SourceCodeUrl)
+	}
+
+	return nil
+}
+
+func resourceAwsServerlessApplicationRepositoryApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).serverlessrepoconn
+
+	if d.HasChange("author") || d.HasChange("description") || d.HasChange("home_page_url") || d.HasChange("labels") || d.HasChange("readme_body") || d.HasChange("readme_url") {
+		input := &serverlessapplicationrepository.UpdateApplicationRequest{
+			ApplicationId: aws.String(d.Id()),
+			Author:        aws.String(d.Get("author").(string)),
+			Description:   aws.String(d.Get("description").(string)),
+			HomePageUrl:   aws.String(d.Get("home_page_url").(string)),
+			Labels:        expandStringSet(d.Get("labels").(*schema.Set)),
+		}
+		if v, ok := d.GetOk("readme_body"); ok {
+			input.ReadmeBody = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("readme_url"); ok {
+			input.ReadmeUrl = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Serverless Application Repository application: %s", input)
+		if _, err := conn.UpdateApplication(input); err != nil {
+			return fmt.Errorf("error updating Serverless Application Repository application (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("semantic_version") {
+		input := &serverlessapplicationrepository.CreateApplicationVersionRequest{
+			ApplicationId:   aws.String(d.Id()),
+			SemanticVersion: aws.String(d.Get("semantic_version").(string)),
+		}
+		if v, ok := d.GetOk("source_code_url"); ok {
+			input.SourceCodeUrl = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("template_body"); ok {
+			input.TemplateBody = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("template_url"); ok {
+			input.TemplateUrl = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating Serverless Application Repository application version: %s", input)
+		if _, err := conn.CreateApplicationVersion(input); err != nil {
+			return fmt.Errorf("error creating Serverless Application Repository application (%s) version: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsServerlessApplicationRepositoryApplicationRead(d, meta)
+}
+
+func resourceAwsServerlessApplicationRepositoryApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).serverlessrepoconn
+
+	log.Printf("[DEBUG] Deleting Serverless Application Repository application: %s", d.Id())
+	_, err := conn.DeleteApplication(&serverlessapplicationrepository.DeleteApplicationInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, serverlessapplicationrepository.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Serverless Application Repository application (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// incrementServerlessApplicationRepositorySemanticVersion returns the
+// version following the given one, incrementing its major, minor or patch
+// part and resetting the less significant parts.
+func incrementServerlessApplicationRepositorySemanticVersion(version, increment string) (string, error) {
+	if version == "" {
+		return serverlessApplicationRepositoryInitialSemanticVersion, nil
+	}
+
+	m := serverlessApplicationRepositorySemanticVersionRegexp.FindStringSubmatch(version)
+	if m == nil {
+		return "", fmt.Errorf("cannot increment semantic version %q: expected MAJOR.MINOR.PATCH", version)
+	}
+
+	parts := make([]int, 3)
+	for i := range parts {
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return "", fmt.Errorf("cannot increment semantic version %q: %s", version, err)
+		}
+		parts[i] = n
+	}
+
+	switch increment {
+	case serverlessApplicationRepositoryIncrementMajor:
+		parts = []int{parts[0] + 1, 0, 0}
+	case serverlessApplicationRepositoryIncrementMinor:
+		parts = []int{parts[0], parts[1] + 1, 0}
+	case serverlessApplicationRepositoryIncrementPatch:
+		parts = []int{parts[0], parts[1], parts[2] + 1}
+	default:
+		return "", fmt.Errorf("unknown semantic version increment %q", increment)
+	}
+
+	return fmt.Sprintf("%d.%d.%d", parts[0], parts[1], parts[2]), nil
+}
@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestIncrementServerlessApplicationRepositorySemanticVersion(t *testing.T) {
+	cases := []struct {
+		Version   string
+		Increment string
+		Expected  string
+		ErrCount  int
+	}{
+		{"", "patch", "1.0.0", 0},
+		{"1.2.3", "patch", "1.2.4", 0},
+		{"1.2.3", "minor", "1.3.0", 0},
+		{"1.2.3", "major", "2.0.0", 0},
+		{"1.9.9", "patch", "1.9.10", 0},
+		{"1.2", "patch", "", 1},
+		{"1.2.3", "build", "", 1},
+	}
+
+	for _, tc := range cases {
+		version, err := incrementServerlessApplicationRepositorySemanticVersion(tc.Version, tc.Increment)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("unexpected error incrementing %q (%s): %s", tc.Version, tc.Increment, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected error incrementing %q (%s)", tc.Version, tc.Increment)
+		}
+		if version != tc.Expected {
+			t.Fatalf("expected %q incrementing %q (%s), got %q", tc.Expected, tc.Version, tc.Increment, version)
+		}
+	}
+}
+
+func TestAccAWSServerlessApplicationRepositoryApplication_basic(t *testing.T) {
+	resourceName := "aws_serverlessapplicationrepository_application.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccHasServicePreCheck(endpoints.ServerlessrepoServiceID, t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServerlessApplicationRepositoryApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServerlessApplicationRepositoryApplicationConfig(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServerlessApplicationRepositoryApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "semantic_version", "1.0.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+			{
+				Config: testAccAWSServerlessApplicationRepositoryApplicationConfig(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServerlessApplicationRepositoryApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "semantic_version", "1.1.0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"license_body", "readme_body", "semantic_version_auto_increment", "template_body"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServerlessApplicationRepositoryApplicationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).serverlessrepoconn
+		_, err := conn.GetApplication(&serverlessapplicationrepository.GetApplicationInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSServerlessApplicationRepositoryApplicationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).serverlessrepoconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_serverlessapplicationrepository_application" {
+			continue
+		}
+
+		_, err := conn.GetApplication(&serverlessapplicationrepository.GetApplicationInput{
+			ApplicationId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, serverlessapplicationrepository.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Serverless Application Repository application (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSServerlessApplicationRepositoryApplicationConfig(rName, topicName string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_application" "test" {
+  name            = %q
+  author          = "Terraform"
+  description     = "Terraform acceptance test"
+  spdx_license_id = "MIT"
+  license_body    = "MIT License"
+  readme_body     = "Terraform acceptance test"
+
+  semantic_version_auto_increment = "minor"
+
+  template_body = <<EOF
+AWSTemplateFormatVersion: '2010-09-09'
+Transform: AWS::Serverless-2016-10-31
+Resources:
+  Topic:
+    Type: AWS::SNS::Topic
+    Properties:
+      TopicName: %s
+EOF
+}
+`, rName, topicName)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -48,6 +49,11 @@ func resourceAwsVolumeAttachment() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"instance_stop_before_detach": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"force_detach"},
+			},
 		},
 	}
 }
@@ -209,7 +215,7 @@ func resourceAwsVolumeAttachmentUpdate(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAwsVolumeAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsVolumeAttachmentDelete(d *schema.ResourceData, meta interface{}) (err error) {
 	conn := meta.(*AWSClient).ec2conn
 
 	if _, ok := d.GetOk("skip_destroy"); ok {
@@ -220,6 +226,26 @@ func resourceAwsVolumeAttachmentDelete(d *schema.ResourceData, meta interface{})
 	vID := d.Get("volume_id").(string)
 	iID := d.Get("instance_id").(string)
 
+	// Only restart the instance if it was running before the detach. Once it
+	// has been stopped, it is restarted even if the detach fails.
+	if d.Get("instance_stop_before_detach").(bool) {
+		instance, state, err := InstanceStateRefreshFunc(conn, iID, []string{})()
+		if err != nil {
+			return fmt.Errorf("Error reading Instance (%s): %s", iID, err)
+		}
+
+		if instance != nil && state != "stopped" && state != "terminated" {
+			if err := volumeAttachmentStopInstance(conn, iID); err != nil {
+				return err
+			}
+			defer func() {
+				err = volumeAttachmentRestartInstance(err, func() error {
+					return volumeAttachmentStartInstance(conn, iID)
+				})
+			}()
+		}
+	}
+
 	opts := &ec2.DetachVolumeInput{
 		Device:     aws.String(name),
 		InstanceId: aws.String(iID),
@@ -227,7 +253,7 @@ func resourceAwsVolumeAttachmentDelete(d *schema.ResourceData, meta interface{})
 		Force:      aws.Bool(d.Get("force_detach").(bool)),
 	}
 
-	_, err := conn.DetachVolume(opts)
+	_, err = conn.DetachVolume(opts)
 	if err != nil {
 		return fmt.Errorf("Failed to detach Volume (%s) from Instance (%s): %s",
 			vID, iID, err)
@@ -245,8 +271,75 @@ func resourceAwsVolumeAttachmentDelete(d *schema.ResourceData, meta interface{})
 	_, err = stateConf.WaitForState()
 	if err != nil {
 		return fmt.Errorf(
-			"Error waiting for Volume (%s) to detach from Instance: %s",
-			vID, iID)
+			"Error waiting for Volume (%s) to detach from Instance (%s): %s",
+			vID, iID, err)
+	}
+
+	return nil
+}
+
+// volumeAttachmentRestartInstance restarts an instance stopped before the
+// detach, returning any restart error alongside the detach error.
+func volumeAttachmentRestartInstance(detachErr error, start func() error) error {
+	if err := start(); err != nil {
+		if detachErr == nil {
+			return err
+		}
+		return multierror.Append(detachErr, err)
+	}
+	return detachErr
+}
+
+func volumeAttachmentStopInstance(conn *ec2.EC2, instanceID string) error {
+	log.Printf("[INFO] Stopping Instance (%s) before detaching volume", instanceID)
+	_, err := conn.StopInstances(&ec2.StopInstancesInput{
+		InstanceIds: []*string{aws.String(instanceID)},
+	})
+	if err != nil {
+		return fmt.Errorf("error stopping instance (%s): %s", instanceID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "running", "shutting-down", "stopping"},
+		Target:     []string{"stopped"},
+		Refresh:    InstanceStateRefreshFunc(conn, instanceID, []string{"terminated"}),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for instance (%s) to stop: %s", instanceID, err)
+	}
+
+	return nil
+}
+
+func volumeAttachmentStartInstance(conn *ec2.EC2, instanceID string) error {
+	log.Printf("[INFO] Starting Instance (%s) after detaching volume", instanceID)
+	_, err := conn.StartInstances(&ec2.StartInstancesInput{
+		InstanceIds: []*string{aws.String(instanceID)},
+	})
+	if err != nil {
+		return fmt.Errorf("error starting instance (%s): %s", instanceID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "stopped"},
+		Target:     []string{"running"},
+		Refresh:    InstanceStateRefreshFunc(conn, instanceID, []string{"terminated"}),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for instance (%s) to become ready: %s",
+			instanceID, err)
 	}
 
 	return nil
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"testing"
 	"time"
 
@@ -64,6 +65,82 @@ func TestAccAWSVolumeAttachment_skipDestroy(t *testing.T) {
 	})
 }
 
+func TestAccAWSVolumeAttachment_instanceStopBeforeDetach(t *testing.T) {
+	var i ec2.Instance
+	var v ec2.Volume
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVolumeAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeAttachmentConfigInstanceStopBeforeDetach(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"aws_volume_attachment.ebs_att", "instance_stop_before_detach", "true"),
+					testAccCheckInstanceExists(
+						"aws_instance.web", &i),
+					testAccCheckVolumeExists(
+						"aws_ebs_volume.example", &v),
+					testAccCheckVolumeAttachmentExists(
+						"aws_volume_attachment.ebs_att", &i, &v),
+				),
+			},
+			{
+				Config: testAccVolumeAttachmentConfigInstanceStopBeforeDetach(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(
+						"aws_instance.web", &i),
+					testAccCheckVolumeAttachmentInstanceState(&i, ec2.InstanceStateNameRunning),
+				),
+			},
+		},
+	})
+}
+
+func TestVolumeAttachmentRestartInstance(t *testing.T) {
+	detachErr := fmt.Errorf("detach failed")
+	startErr := fmt.Errorf("start failed")
+
+	cases := []struct {
+		DetachErr error
+		StartErr  error
+		Expected  []error
+	}{
+		{nil, nil, nil},
+		{detachErr, nil, []error{detachErr}},
+		{nil, startErr, []error{startErr}},
+		{detachErr, startErr, []error{detachErr, startErr}},
+	}
+
+	for i, tc := range cases {
+		started := false
+		err := volumeAttachmentRestartInstance(tc.DetachErr, func() error {
+			started = true
+			return tc.StartErr
+		})
+
+		if !started {
+			t.Fatalf("case %d: expected instance to be restarted", i)
+		}
+		if tc.Expected == nil {
+			if err != nil {
+				t.Fatalf("case %d: expected no error, got %s", i, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Fatalf("case %d: expected error, got none", i)
+		}
+		for _, e := range tc.Expected {
+			if !strings.Contains(err.Error(), e.Error()) {
+				t.Fatalf("case %d: expected error to contain %q, got %q", i, e, err)
+			}
+		}
+	}
+}
+
 func TestAccAWSVolumeAttachment_attachStopped(t *testing.T) {
 	var i ec2.Instance
 	var v ec2.Volume
@@ -175,6 +252,16 @@ func testAccCheckVolumeAttachmentExists(n string, i *ec2.Instance, v *ec2.Volume
 	}
 }
 
+func testAccCheckVolumeAttachmentInstanceState(i *ec2.Instance, state string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.StringValue(i.State.Name); got != state {
+			return fmt.Errorf("expected instance (%s) state %s, got %s", aws.StringValue(i.InstanceId), state, got)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckVolumeAttachmentDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		log.Printf("\n\n----- This is never called")
@@ -259,6 +346,36 @@ resource "aws_volume_attachment" "ebs_att" {
 }
 `
 
+func testAccVolumeAttachmentConfigInstanceStopBeforeDetach(attached bool) string {
+	var attachment string
+	if attached {
+		attachment = `
+resource "aws_volume_attachment" "ebs_att" {
+  device_name                 = "/dev/sdh"
+  volume_id                   = "${aws_ebs_volume.example.id}"
+  instance_id                 = "${aws_instance.web.id}"
+  instance_stop_before_detach = true
+}
+`
+	}
+
+	return `
+resource "aws_instance" "web" {
+  ami = "ami-21f78e11"
+  availability_zone = "us-west-2a"
+  instance_type = "t1.micro"
+  tags {
+    Name = "HelloWorld"
+  }
+}
+
+resource "aws_ebs_volume" "example" {
+  availability_zone = "us-west-2a"
+  size = 1
+}
+` + attachment
+}
+
 func testAccVolumeAttachmentConfig_update(detach bool) string {
 	return fmt.Sprintf(`
 resource "aws_instance" "web" {
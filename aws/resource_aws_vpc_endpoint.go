@@ -117,8 +117,32 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				},
 			},
 			"auto_accept": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"auto_accept_connection"},
+			},
+			"auto_accept_connection": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"auto_accept"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"external_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"session_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
 			},
 		},
 
@@ -166,8 +190,8 @@ func resourceAwsVpcEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 	vpce := resp.VpcEndpoint
 	d.SetId(aws.StringValue(vpce.VpcEndpointId))
 
-	if _, ok := d.GetOk("auto_accept"); ok && aws.StringValue(vpce.State) == "pendingAcceptance" {
-		if err := vpcEndpointAccept(conn, d.Id(), aws.StringValue(vpce.ServiceName)); err != nil {
+	if aws.StringValue(vpce.State) == "pendingAcceptance" {
+		if err := vpcEndpointAutoAccept(d, conn, aws.StringValue(vpce.ServiceName)); err != nil {
 			return err
 		}
 	}
@@ -206,8 +230,8 @@ func resourceAwsVpcEndpointRead(d *schema.ResourceData, meta interface{}) error
 func resourceAwsVpcEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
-	if _, ok := d.GetOk("auto_accept"); ok && d.Get("state").(string) == "pendingAcceptance" {
-		if err := vpcEndpointAccept(conn, d.Id(), d.Get("service_name").(string)); err != nil {
+	if d.Get("state").(string) == "pendingAcceptance" {
+		if err := vpcEndpointAutoAccept(d, conn, d.Get("service_name").(string)); err != nil {
 			return err
 		}
 	}
@@ -279,6 +303,27 @@ func resourceAwsVpcEndpointDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// vpcEndpointAutoAccept accepts a pending VPC endpoint connection, either
+// directly or, with auto_accept_connection, as the service owner account.
+func vpcEndpointAutoAccept(d *schema.ResourceData, conn *ec2.EC2, svcName string) error {
+	if _, ok := d.GetOk("auto_accept"); ok {
+		return vpcEndpointAccept(conn, d.Id(), svcName)
+	}
+
+	l := d.Get("auto_accept_connection").([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	ownerConn, err := ec2ConnWithAssumedRole(conn, m["role_arn"].(string), m["external_id"].(string), m["session_name"].(string), "")
+	if err != nil {
+		return err
+	}
+
+	return vpcEndpointAccept(ownerConn, d.Id(), svcName)
+}
+
 func vpcEndpointAccept(conn *ec2.EC2, vpceId, svcName string) error {
 	describeSvcReq := &ec2.DescribeVpcEndpointServiceConfigurationsInput{}
 	describeSvcReq.Filters = buildEC2AttributeFilterList(
@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsVpcEndpointPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcEndpointPolicyPut,
+		Read:   resourceAwsVpcEndpointPolicyRead,
+		Update: resourceAwsVpcEndpointPolicyPut,
+		Delete: resourceAwsVpcEndpointPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.ValidateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsVpcEndpointPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointId := d.Get("vpc_endpoint_id").(string)
+	req := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: aws.String(endpointId),
+	}
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy"))
+	if err != nil {
+		return fmt.Errorf("policy contains an invalid JSON: %s", err)
+	}
+
+	if policy == "" {
+		req.ResetPolicy = aws.Bool(true)
+	} else {
+		req.PolicyDocument = aws.String(policy)
+	}
+
+	log.Printf("[DEBUG] Updating VPC Endpoint Policy: %#v", req)
+	if _, err := conn.ModifyVpcEndpoint(req); err != nil {
+		return fmt.Errorf("Error updating VPC Endpoint (%s) policy: %s", endpointId, err)
+	}
+
+	d.SetId(endpointId)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	if err := vpcEndpointWaitUntilAvailable(conn, endpointId, timeout); err != nil {
+		return err
+	}
+
+	return resourceAwsVpcEndpointPolicyRead(d, meta)
+}
+
+func resourceAwsVpcEndpointPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	vpce, err := findResourceVpcEndpoint(conn, d.Id())
+	if err != nil {
+		if isAWSErr(err, "InvalidVpcEndpointId.NotFound", "") {
+			log.Printf("[WARN] VPC Endpoint (%s) not found, removing VPC Endpoint Policy from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set("vpc_endpoint_id", vpce.VpcEndpointId)
+
+	policy, err := structure.NormalizeJsonString(aws.StringValue(vpce.PolicyDocument))
+	if err != nil {
+		return fmt.Errorf("policy contains an invalid JSON: %s", err)
+	}
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceAwsVpcEndpointPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Resetting VPC Endpoint (%s) policy", d.Id())
+	_, err := conn.ModifyVpcEndpoint(&ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: aws.String(d.Id()),
+		ResetPolicy:   aws.Bool(true),
+	})
+	if isAWSErr(err, "InvalidVpcEndpointId.NotFound", "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error resetting VPC Endpoint (%s) policy: %s", d.Id(), err)
+	}
+
+	return vpcEndpointWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+}
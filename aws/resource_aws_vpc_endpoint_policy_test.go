@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSVpcEndpointPolicy_basic(t *testing.T) {
+	resourceName := "aws_vpc_endpoint_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcEndpointPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointPolicyConfig(rName, "s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointPolicyExists(resourceName, "s3:GetObject"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_endpoint_id", "aws_vpc_endpoint.test", "id"),
+				),
+			},
+			{
+				Config: testAccVpcEndpointPolicyConfig(rName, "s3:PutObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointPolicyExists(resourceName, "s3:PutObject"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVpcEndpointPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_endpoint_policy" {
+			continue
+		}
+
+		vpce, err := findResourceVpcEndpoint(conn, rs.Primary.ID)
+		if isAWSErr(err, "InvalidVpcEndpointId.NotFound", "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// Destroying the policy resets the endpoint to the default full access policy.
+		if policy := aws.StringValue(vpce.PolicyDocument); regexp.MustCompile(`s3:(Get|Put)Object`).MatchString(policy) {
+			return fmt.Errorf("VPC Endpoint %s policy was not reset: %s", rs.Primary.ID, policy)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVpcEndpointPolicyExists(n, action string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		vpce, err := findResourceVpcEndpoint(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if policy := aws.StringValue(vpce.PolicyDocument); !regexp.MustCompile(regexp.QuoteMeta(action)).MatchString(policy) {
+			return fmt.Errorf("VPC Endpoint %s policy doesn't allow %s: %s", rs.Primary.ID, action, policy)
+		}
+
+		return nil
+	}
+}
+
+func testAccVpcEndpointPolicyConfig(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_endpoint" "test" {
+  vpc_id       = "${aws_vpc.test.id}"
+  service_name = "com.amazonaws.${data.aws_region.current.name}.s3"
+}
+
+resource "aws_vpc_endpoint_policy" "test" {
+  vpc_endpoint_id = "${aws_vpc_endpoint.test.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "%[2]s",
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+}
+`, rName, action)
+}
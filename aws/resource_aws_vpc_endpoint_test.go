@@ -190,6 +190,30 @@ func TestAccAWSVpcEndpoint_interfaceNonAWSService(t *testing.T) {
 		},
 	})
 }
+func TestAccAWSVpcEndpoint_interfaceNonAWSServiceAutoAcceptConnection(t *testing.T) {
+	lbName := fmt.Sprintf("testaccawsnlb-accept-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	roleName := acctest.RandomWithPrefix("tf-acc-test")
+	var endpoint ec2.VpcEndpoint
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_vpc_endpoint.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointConfig_interfaceNonAWSServiceAutoAcceptConnection(lbName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.foo", &endpoint),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.foo", "vpc_endpoint_type", "Interface"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.foo", "auto_accept_connection.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.foo", "state", "available"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSVpcEndpoint_removed(t *testing.T) {
 	var endpoint ec2.VpcEndpoint
 
@@ -635,3 +659,125 @@ resource "aws_vpc_endpoint" "foo" {
 }
   `, lbName)
 }
+
+func testAccVpcEndpointConfig_interfaceNonAWSServiceAutoAcceptConnection(lbName, roleName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "foo" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-iface-non-aws-svc-accept"
+  }
+}
+
+resource "aws_lb" "nlb_test_1" {
+  name = "%s"
+
+  subnets = [
+    "${aws_subnet.nlb_test_1.id}",
+    "${aws_subnet.nlb_test_2.id}",
+  ]
+
+  load_balancer_type         = "network"
+  internal                   = true
+  idle_timeout               = 60
+  enable_deletion_protection = false
+
+  tags {
+    Name = "testAccVpcEndpointServiceAutoAcceptConnectionConfig_nlb1"
+  }
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_subnet" "nlb_test_1" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+
+  tags {
+    Name = "tf-acc-vpc-endpoint-iface-non-aws-svc-accept-1"
+  }
+}
+
+resource "aws_subnet" "nlb_test_2" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.0.2.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+
+  tags {
+    Name = "tf-acc-vpc-endpoint-iface-non-aws-svc-accept-2"
+  }
+}
+
+resource "aws_vpc_endpoint_service" "foo" {
+  acceptance_required = true
+
+  network_load_balancer_arns = [
+    "${aws_lb.nlb_test_1.id}",
+  ]
+}
+
+resource "aws_security_group" "sg1" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+data "aws_caller_identity" "current" {}
+
+# The service owner role is in the same account here, it would usually be in
+# the account owning the endpoint service.
+resource "aws_iam_role" "owner" {
+  name = "%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": "arn:aws:iam::${data.aws_caller_identity.current.account_id}:root"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "owner" {
+  role = "${aws_iam_role.owner.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:AcceptVpcEndpointConnections",
+        "ec2:DescribeVpcEndpointServiceConfigurations"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_vpc_endpoint" "foo" {
+  vpc_id              = "${aws_vpc.foo.id}"
+  service_name        = "${aws_vpc_endpoint_service.foo.service_name}"
+  vpc_endpoint_type   = "Interface"
+  security_group_ids  = ["${aws_security_group.sg1.id}"]
+  private_dns_enabled = false
+
+  auto_accept_connection {
+    role_arn     = "${aws_iam_role.owner.arn}"
+    session_name = "tf-acc-test"
+  }
+
+  depends_on = ["aws_iam_role_policy.owner"]
+}
+`, lbName, roleName)
+}
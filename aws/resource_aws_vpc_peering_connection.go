@@ -59,6 +59,29 @@ func resourceAwsVpcPeeringConnection() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"peer_assume_role": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"auto_accept"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"external_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"session_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"accepter":  vpcPeeringConnectionOptionsSchema(),
 			"requester": vpcPeeringConnectionOptionsSchema(),
 			"tags":      tagsSchema(),
@@ -195,16 +218,17 @@ func resourceVPCPeeringConnectionAccept(conn *ec2.EC2, id string) (string, error
 	return *pc.Status.Code, nil
 }
 
-func resourceAwsVpcPeeringConnectionModifyOptions(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsVpcPeeringConnectionModifyOptions(d *schema.ResourceData, meta interface{}, peerConn *ec2.EC2) error {
 	conn := meta.(*AWSClient).ec2conn
 
 	req := &ec2.ModifyVpcPeeringConnectionOptionsInput{
 		VpcPeeringConnectionId: aws.String(d.Id()),
 	}
 
+	var accepterOptions *ec2.PeeringConnectionOptionsRequest
 	v := d.Get("accepter").(*schema.Set).List()
 	if len(v) > 0 {
-		req.AccepterPeeringConnectionOptions = expandVpcPeeringConnectionOptions(v[0].(map[string]interface{}))
+		accepterOptions = expandVpcPeeringConnectionOptions(v[0].(map[string]interface{}))
 	}
 
 	v = d.Get("requester").(*schema.Set).List()
@@ -212,6 +236,25 @@ func resourceAwsVpcPeeringConnectionModifyOptions(d *schema.ResourceData, meta i
 		req.RequesterPeeringConnectionOptions = expandVpcPeeringConnectionOptions(v[0].(map[string]interface{}))
 	}
 
+	// Accepter options can only be modified by the accepter account.
+	if peerConn != nil && accepterOptions != nil {
+		accepterReq := &ec2.ModifyVpcPeeringConnectionOptionsInput{
+			VpcPeeringConnectionId:           aws.String(d.Id()),
+			AccepterPeeringConnectionOptions: accepterOptions,
+		}
+
+		log.Printf("[DEBUG] Modifying VPC Peering Connection accepter options: %#v", accepterReq)
+		if _, err := peerConn.ModifyVpcPeeringConnectionOptions(accepterReq); err != nil {
+			return err
+		}
+	} else {
+		req.AccepterPeeringConnectionOptions = accepterOptions
+	}
+
+	if req.AccepterPeeringConnectionOptions == nil && req.RequesterPeeringConnectionOptions == nil {
+		return nil
+	}
+
 	log.Printf("[DEBUG] Modifying VPC Peering Connection options: %#v", req)
 	if _, err := conn.ModifyVpcPeeringConnectionOptions(req); err != nil {
 		return err
@@ -242,9 +285,28 @@ func resourceAwsVPCPeeringUpdate(d *schema.ResourceData, meta interface{}) error
 
 	pc := pcRaw.(*ec2.VpcPeeringConnection)
 
-	if _, ok := d.GetOk("auto_accept"); ok {
-		if pc.Status != nil && *pc.Status.Code == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance {
-			status, err := resourceVPCPeeringConnectionAccept(conn, d.Id())
+	pendingAcceptance := pc.Status != nil && *pc.Status.Code == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance
+	optionsChanged := d.HasChange("accepter") || d.HasChange("requester")
+
+	// The peer account role is only needed to accept the peering connection
+	// or to modify its options, so e.g. tag changes don't assume it.
+	var peerConn *ec2.EC2
+	if pendingAcceptance || optionsChanged {
+		peerConn, err = vpcPeeringConnectionPeerConn(d, conn)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, autoAccept := d.GetOk("auto_accept")
+	if pendingAcceptance {
+		acceptConn := conn
+		if peerConn != nil {
+			acceptConn = peerConn
+		}
+
+		if autoAccept || peerConn != nil {
+			status, err := resourceVPCPeeringConnectionAccept(acceptConn, d.Id())
 			if err != nil {
 				return fmt.Errorf("Unable to accept VPC Peering Connection: %s", err)
 			}
@@ -252,17 +314,26 @@ func resourceAwsVPCPeeringUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("accepter") || d.HasChange("requester") {
-		_, ok := d.GetOk("auto_accept")
-		if !ok && pc.Status != nil && *pc.Status.Code != "active" {
+	if optionsChanged {
+		if !autoAccept && peerConn == nil && pc.Status != nil && *pc.Status.Code != "active" {
 			return fmt.Errorf("Unable to modify peering options. The VPC Peering Connection "+
 				"%q is not active. Please set `auto_accept` attribute to `true`, "+
-				"or activate VPC Peering Connection manually.", d.Id())
+				"configure `peer_assume_role`, or activate VPC Peering Connection manually.", d.Id())
 		}
 
-		if err := resourceAwsVpcPeeringConnectionModifyOptions(d, meta); err != nil {
+		if peerConn != nil {
+			if err := vpcPeeringConnectionWaitUntilActive(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+
+		if err := resourceAwsVpcPeeringConnectionModifyOptions(d, meta, peerConn); err != nil {
 			return fmt.Errorf("Error modifying VPC Peering Connection options: %s", err)
 		}
+
+		if err := vpcPeeringConnectionWaitForOptions(d, conn, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
 	}
 
 	err = vpcPeeringConnectionWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
@@ -376,6 +447,101 @@ func vpcPeeringConnectionOptionsSchema() *schema.Schema {
 	}
 }
 
+// vpcPeeringConnectionPeerConn returns an EC2 client for the accepter account
+// and region when peer_assume_role is configured, otherwise nil.
+func vpcPeeringConnectionPeerConn(d *schema.ResourceData, conn *ec2.EC2) (*ec2.EC2, error) {
+	l := d.Get("peer_assume_role").([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	m := l[0].(map[string]interface{})
+	peerConn, err := ec2ConnWithAssumedRole(conn, m["role_arn"].(string), m["external_id"].(string), m["session_name"].(string), d.Get("peer_region").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error configuring VPC Peering Connection accepter: %s", err)
+	}
+
+	return peerConn, nil
+}
+
+func vpcPeeringConnectionWaitUntilActive(conn *ec2.EC2, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for VPC Peering Connection (%s) to become active.", id)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest,
+			ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+			ec2.VpcPeeringConnectionStateReasonCodeProvisioning,
+		},
+		Target:  []string{ec2.VpcPeeringConnectionStateReasonCodeActive},
+		Refresh: vpcPeeringConnectionRefreshState(conn, id),
+		Timeout: timeout,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Peering Connection (%s) to become active: %s", id, err)
+	}
+	return nil
+}
+
+// vpcPeeringConnectionWaitForOptions waits until the requester and accepter
+// options reported by the requester match the configuration, as changes made
+// by the accepter account take a while to propagate.
+func vpcPeeringConnectionWaitForOptions(d *schema.ResourceData, conn *ec2.EC2, timeout time.Duration) error {
+	want := map[string][]interface{}{
+		"accepter":  d.Get("accepter").(*schema.Set).List(),
+		"requester": d.Get("requester").(*schema.Set).List(),
+	}
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		pcRaw, _, err := vpcPeeringConnectionRefreshState(conn, d.Id())()
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error reading VPC Peering Connection: %s", err))
+		}
+		if pcRaw == nil {
+			return resource.RetryableError(fmt.Errorf("VPC Peering Connection (%s) not found", d.Id()))
+		}
+
+		pc := pcRaw.(*ec2.VpcPeeringConnection)
+
+		// The VPC information only carries peering options once the
+		// peering connection is active.
+		if pc.AccepterVpcInfo == nil || pc.RequesterVpcInfo == nil {
+			return resource.RetryableError(fmt.Errorf("VPC Peering Connection (%s) is pending", d.Id()))
+		}
+
+		got := map[string]*ec2.VpcPeeringConnectionOptionsDescription{
+			"accepter":  pc.AccepterVpcInfo.PeeringOptions,
+			"requester": pc.RequesterVpcInfo.PeeringOptions,
+		}
+
+		for side, options := range want {
+			if len(options) == 0 {
+				continue
+			}
+
+			if !vpcPeeringConnectionOptionsMatch(options[0].(map[string]interface{}), got[side]) {
+				return resource.RetryableError(fmt.Errorf("VPC Peering Connection (%s) %s options not yet propagated", d.Id(), side))
+			}
+		}
+
+		return nil
+	})
+}
+
+func vpcPeeringConnectionOptionsMatch(want map[string]interface{}, got *ec2.VpcPeeringConnectionOptionsDescription) bool {
+	if got == nil {
+		return false
+	}
+
+	actual := flattenVpcPeeringConnectionOptions(got)[0]
+	for k, v := range want {
+		if actual[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
 func vpcPeeringConnectionWaitUntilAvailable(conn *ec2.EC2, id string, timeout time.Duration) error {
 	// Wait for the vpc peering connection to become available
 	log.Printf("[DEBUG] Waiting for VPC Peering Connection (%s) to become available.", id)
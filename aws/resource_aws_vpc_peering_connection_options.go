@@ -3,7 +3,9 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -18,6 +20,12 @@ func resourceAwsVpcPeeringConnectionOptions() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			// Options set in another account can take minutes to propagate.
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpc_peering_connection_id": {
 				Type:     schema.TypeString,
@@ -71,13 +79,87 @@ func resourceAwsVpcPeeringConnectionOptionsRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsVpcPeeringConnectionOptionsUpdate(d *schema.ResourceData, meta interface{}) error {
-	if err := resourceAwsVpcPeeringConnectionModifyOptions(d, meta); err != nil {
-		return fmt.Errorf("Error modifying VPC Peering Connection Options: %s", err.Error())
+	client := meta.(*AWSClient)
+	conn := client.ec2conn
+
+	pcRaw, _, err := vpcPeeringConnectionRefreshState(conn, d.Id())()
+	if err != nil {
+		return fmt.Errorf("Error reading VPC Peering Connection: %s", err.Error())
+	}
+
+	if pcRaw == nil {
+		return fmt.Errorf("VPC Peering Connection (%s) not found", d.Id())
+	}
+
+	pc := pcRaw.(*ec2.VpcPeeringConnection)
+
+	// Both sides are modified in a single request, so a configuration
+	// that is invalid for either side leaves the connection unchanged.
+	req := &ec2.ModifyVpcPeeringConnectionOptionsInput{
+		VpcPeeringConnectionId: aws.String(d.Id()),
+	}
+
+	if v := d.Get("accepter").(*schema.Set).List(); d.HasChange("accepter") && len(v) > 0 {
+		m := v[0].(map[string]interface{})
+		if err := validateVpcPeeringConnectionOptions(client, "accepter", pc.AccepterVpcInfo, pc.RequesterVpcInfo, m); err != nil {
+			return err
+		}
+		req.AccepterPeeringConnectionOptions = expandVpcPeeringConnectionOptions(m)
+	}
+
+	if v := d.Get("requester").(*schema.Set).List(); d.HasChange("requester") && len(v) > 0 {
+		m := v[0].(map[string]interface{})
+		if err := validateVpcPeeringConnectionOptions(client, "requester", pc.RequesterVpcInfo, pc.AccepterVpcInfo, m); err != nil {
+			return err
+		}
+		req.RequesterPeeringConnectionOptions = expandVpcPeeringConnectionOptions(m)
+	}
+
+	if req.AccepterPeeringConnectionOptions != nil || req.RequesterPeeringConnectionOptions != nil {
+		log.Printf("[DEBUG] Modifying VPC Peering Connection options: %#v", req)
+		if _, err := conn.ModifyVpcPeeringConnectionOptions(req); err != nil {
+			return fmt.Errorf("Error modifying VPC Peering Connection Options: %s", err.Error())
+		}
+
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+
+		if err := vpcPeeringConnectionWaitForOptions(d, conn, timeout); err != nil {
+			return fmt.Errorf("Error waiting for VPC Peering Connection (%s) options to propagate: %s", d.Id(), err)
+		}
 	}
 
 	return resourceAwsVpcPeeringConnectionOptionsRead(d, meta)
 }
 
+// validateVpcPeeringConnectionOptions ensures the options of one side of
+// a VPC Peering Connection are only set by the account and in the region
+// owning that side, which is the only place the API accepts them.
+func validateVpcPeeringConnectionOptions(client *AWSClient, side string, info, peerInfo *ec2.VpcPeeringConnectionVpcInfo, m map[string]interface{}) error {
+	if info == nil {
+		return fmt.Errorf("VPC Peering Connection %s information is missing", side)
+	}
+
+	if owner := aws.StringValue(info.OwnerId); client.accountid != "" && owner != client.accountid {
+		return fmt.Errorf("%s options can only be set by the %s account (%s), use a provider for that account instead", side, side, owner)
+	}
+
+	region := aws.StringValue(info.Region)
+	if region != "" && region != client.region {
+		return fmt.Errorf("%s options can only be set in the %s region (%s), use a provider for that region instead", side, side, region)
+	}
+
+	if peerInfo != nil && aws.StringValue(peerInfo.Region) != "" && aws.StringValue(peerInfo.Region) != region {
+		if m["allow_classic_link_to_remote_vpc"].(bool) || m["allow_vpc_to_remote_classic_link"].(bool) {
+			return fmt.Errorf("%s ClassicLink options are not supported for inter-region VPC Peering Connections", side)
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsVpcPeeringConnectionOptionsDelete(d *schema.ResourceData, meta interface{}) error {
 	// Don't do anything with the underlying VPC peering connection.
 	return nil
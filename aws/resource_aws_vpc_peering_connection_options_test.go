@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -122,3 +123,86 @@ resource "aws_vpc_peering_connection_options" "foo" {
   }
 }
 `
+
+func TestValidateVpcPeeringConnectionOptions(t *testing.T) {
+	client := &AWSClient{accountid: "111111111111", region: "us-west-2"}
+	options := map[string]interface{}{
+		"allow_remote_vpc_dns_resolution":  true,
+		"allow_classic_link_to_remote_vpc": false,
+		"allow_vpc_to_remote_classic_link": false,
+	}
+	classicLinkOptions := map[string]interface{}{
+		"allow_remote_vpc_dns_resolution":  false,
+		"allow_classic_link_to_remote_vpc": true,
+		"allow_vpc_to_remote_classic_link": false,
+	}
+	local := &ec2.VpcPeeringConnectionVpcInfo{
+		OwnerId: aws.String("111111111111"),
+		Region:  aws.String("us-west-2"),
+	}
+
+	cases := []struct {
+		Name          string
+		Info          *ec2.VpcPeeringConnectionVpcInfo
+		PeerInfo      *ec2.VpcPeeringConnectionVpcInfo
+		Options       map[string]interface{}
+		ExpectedError string
+	}{
+		{
+			Name:     "same account and region",
+			Info:     local,
+			PeerInfo: local,
+			Options:  options,
+		},
+		{
+			Name: "other account",
+			Info: &ec2.VpcPeeringConnectionVpcInfo{
+				OwnerId: aws.String("222222222222"),
+				Region:  aws.String("us-west-2"),
+			},
+			PeerInfo:      local,
+			Options:       options,
+			ExpectedError: "account (222222222222)",
+		},
+		{
+			Name: "other region",
+			Info: &ec2.VpcPeeringConnectionVpcInfo{
+				OwnerId: aws.String("111111111111"),
+				Region:  aws.String("us-east-1"),
+			},
+			PeerInfo:      local,
+			Options:       options,
+			ExpectedError: "region (us-east-1)",
+		},
+		{
+			Name: "inter-region DNS resolution",
+			Info: local,
+			PeerInfo: &ec2.VpcPeeringConnectionVpcInfo{
+				OwnerId: aws.String("111111111111"),
+				Region:  aws.String("us-east-1"),
+			},
+			Options: options,
+		},
+		{
+			Name: "inter-region ClassicLink",
+			Info: local,
+			PeerInfo: &ec2.VpcPeeringConnectionVpcInfo{
+				OwnerId: aws.String("111111111111"),
+				Region:  aws.String("us-east-1"),
+			},
+			Options:       classicLinkOptions,
+			ExpectedError: "not supported for inter-region",
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateVpcPeeringConnectionOptions(client, "accepter", tc.Info, tc.PeerInfo, tc.Options)
+
+		if tc.ExpectedError == "" && err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.Name, err)
+		}
+		if tc.ExpectedError != "" && (err == nil || !strings.Contains(err.Error(), tc.ExpectedError)) {
+			t.Errorf("%s: expected error containing %q, got: %v", tc.Name, tc.ExpectedError, err)
+		}
+	}
+}
@@ -549,3 +549,39 @@ resource "aws_vpc_peering_connection" "foo" {
   peer_region = "us-east-1"
 }
 `
+
+func TestVpcPeeringConnectionOptionsMatch(t *testing.T) {
+	got := &ec2.VpcPeeringConnectionOptionsDescription{
+		AllowDnsResolutionFromRemoteVpc:            aws.Bool(true),
+		AllowEgressFromLocalClassicLinkToRemoteVpc: aws.Bool(false),
+		AllowEgressFromLocalVpcToRemoteClassicLink: aws.Bool(false),
+	}
+
+	cases := []struct {
+		Want     map[string]interface{}
+		Got      *ec2.VpcPeeringConnectionOptionsDescription
+		Expected bool
+	}{
+		{
+			Want:     map[string]interface{}{"allow_remote_vpc_dns_resolution": true},
+			Got:      got,
+			Expected: true,
+		},
+		{
+			Want:     map[string]interface{}{"allow_remote_vpc_dns_resolution": true, "allow_classic_link_to_remote_vpc": true},
+			Got:      got,
+			Expected: false,
+		},
+		{
+			Want:     map[string]interface{}{"allow_remote_vpc_dns_resolution": true},
+			Got:      nil,
+			Expected: false,
+		},
+	}
+
+	for i, tc := range cases {
+		if actual := vpcPeeringConnectionOptionsMatch(tc.Want, tc.Got); actual != tc.Expected {
+			t.Errorf("case %d: expected %t, got %t", i, tc.Expected, actual)
+		}
+	}
+}
@@ -2046,3 +2046,17 @@ func validateSecretManagerSecretNamePrefix(v interface{}, k string) (ws []string
 	}
 	return
 }
+
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be parsed as a duration, e.g. 30m or 1h: %q", k, value))
+	} else if duration <= 0 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a positive duration: %q", k, value))
+	}
+
+	return
+}
@@ -3017,3 +3017,25 @@ func TestValidateSecretManagerSecretNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateDuration(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "30m", ErrCount: 0},
+		{Value: "1h30m", ErrCount: 0},
+		{Value: "90s", ErrCount: 0},
+		{Value: "0s", ErrCount: 1},
+		{Value: "-5m", ErrCount: 1},
+		{Value: "30", ErrCount: 1},
+		{Value: "thirty minutes", ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateDuration(tc.Value, "create")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
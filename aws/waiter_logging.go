@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// logStateChangeConf wraps the Refresh function of a waiter in place and logs
+// every state transition of the waited for resource at INFO level, as a line
+// of space separated key=value pairs with the keys resource_type, id, state,
+// previous_state, pending and elapsed. External tooling can use these lines to
+// follow the progress of long running applies. Every other poll is logged at
+// DEBUG level and refresh errors are logged with an error key. The elapsed
+// time is measured from the call, so it should be made right before
+// WaitForState.
+func logStateChangeConf(resourceType, id string, conf *resource.StateChangeConf) {
+	refresh := conf.Refresh
+
+	start := time.Now()
+	var previousState string
+	first := true
+
+	conf.Refresh = func() (interface{}, string, error) {
+		result, state, err := refresh()
+
+		elapsed := time.Since(start).Truncate(time.Second)
+		if err != nil {
+			log.Printf("[INFO] waiter: resource_type=%s id=%s state=%s error=%q elapsed=%s",
+				resourceType, id, loggingStateValue(state), err, elapsed)
+		} else if first || state != previousState {
+			log.Printf("[INFO] waiter: resource_type=%s id=%s state=%s previous_state=%s pending=%s elapsed=%s",
+				resourceType, id, loggingStateValue(state), loggingStateValue(previousState), strings.Join(conf.Pending, ","), elapsed)
+		} else {
+			log.Printf("[DEBUG] waiter: resource_type=%s id=%s state=%s elapsed=%s",
+				resourceType, id, loggingStateValue(state), elapsed)
+		}
+
+		first = false
+		previousState = state
+
+		return result, state, err
+	}
+}
+
+func loggingStateValue(state string) string {
+	if state == "" {
+		return "-"
+	}
+
+	return state
+}
@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestLogStateChangeConf(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	states := []string{"creating", "creating", "available"}
+	calls := 0
+	conf := &resource.StateChangeConf{
+		Pending: []string{"creating", "modifying"},
+		Refresh: func() (interface{}, string, error) {
+			state := states[calls]
+			calls++
+			return state, state, nil
+		},
+	}
+	logStateChangeConf("aws_test", "test-id", conf)
+	refresh := conf.Refresh
+
+	for _, expected := range states {
+		result, state, err := refresh()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if state != expected || result.(string) != expected {
+			t.Fatalf("expected state %q, got %q (%v)", expected, state, result)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 log lines, got %d:\n%s", len(lines), buf.String())
+	}
+	for i, expected := range []string{
+		"[INFO] waiter: resource_type=aws_test id=test-id state=creating previous_state=- pending=creating,modifying elapsed=",
+		"[DEBUG] waiter: resource_type=aws_test id=test-id state=creating elapsed=",
+		"[INFO] waiter: resource_type=aws_test id=test-id state=available previous_state=creating pending=creating,modifying elapsed=",
+	} {
+		if !strings.Contains(lines[i], expected) {
+			t.Fatalf("expected log line %d to contain %q, got %q", i, expected, lines[i])
+		}
+	}
+
+	buf.Reset()
+	conf = &resource.StateChangeConf{
+		Refresh: func() (interface{}, string, error) {
+			return nil, "failed", fmt.Errorf("boom")
+		},
+	}
+	logStateChangeConf("aws_test", "test-id", conf)
+	refresh = conf.Refresh
+	if _, _, err := refresh(); err == nil {
+		t.Fatal("expected error")
+	}
+	if expected := `state=failed error="boom"`; !strings.Contains(buf.String(), expected) {
+		t.Fatalf("expected log to contain %q, got %q", expected, buf.String())
+	}
+
+	// The elapsed time includes the Delay before the first refresh.
+	buf.Reset()
+	conf = &resource.StateChangeConf{
+		Refresh: func() (interface{}, string, error) {
+			return "available", "available", nil
+		},
+	}
+	logStateChangeConf("aws_test", "test-id", conf)
+	time.Sleep(1 * time.Second)
+	if _, _, err := conf.Refresh(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := "elapsed=1s"; !strings.Contains(buf.String(), expected) {
+		t.Fatalf("expected log to contain %q, got %q", expected, buf.String())
+	}
+}